@@ -9,12 +9,26 @@ import (
 	"github.com/daill/go-chart/util"
 )
 
+// BarOrientation is the direction bars grow in.
+type BarOrientation int
+
+const (
+	// BarOrientationVertical draws bars that grow bottom-to-top with the labels along the bottom.
+	BarOrientationVertical BarOrientation = 0
+	// BarOrientationHorizontal draws bars that grow left-to-right with the labels along the left.
+	BarOrientationHorizontal BarOrientation = 1
+)
+
 // BarChart is a chart that draws bars on a range.
+// If the orientation is horizontal, the `XAxis` style applies to the category labels on the left
+// and the `YAxis` applies to the value ticks along the bottom.
 type BarChart struct {
 	Title      string
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -30,6 +44,11 @@ type BarChart struct {
 
 	BarSpacing int
 
+	Orientation BarOrientation
+
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -38,8 +57,11 @@ type BarChart struct {
 }
 
 // GetDPI returns the dpi for the chart.
-func (bc BarChart) GetDPI() float64 {
+func (bc BarChart) GetDPI(defaults ...float64) float64 {
 	if bc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
 		return DefaultDPI
 	}
 	return bc.DPI
@@ -48,6 +70,9 @@ func (bc BarChart) GetDPI() float64 {
 // GetFont returns the text font.
 func (bc BarChart) GetFont() *truetype.Font {
 	if bc.Font == nil {
+		if theme := bc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return bc.defaultFont
 	}
 	return bc.Font
@@ -85,10 +110,23 @@ func (bc BarChart) GetBarWidth() int {
 	return bc.BarWidth
 }
 
+// IsHorizontal returns if the bars grow left-to-right.
+func (bc BarChart) IsHorizontal() bool {
+	return bc.Orientation == BarOrientationHorizontal
+}
+
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (bc BarChart) Validate() error {
+	if len(bc.Bars) == 0 {
+		return FieldError{Field: "Bars", Err: errors.New("please provide at least one bar")}
+	}
+	return nil
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(bc.Bars) == 0 {
-		return errors.New("please provide at least one bar")
+	if err := bc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(bc.GetWidth(), bc.GetHeight())
@@ -112,7 +150,8 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	var yr Range
 	var yf ValueFormatter
 
-	canvasBox = bc.getDefaultCanvasBox()
+	legend := bc.getLegend()
+	canvasBox = legend.adjustCanvasBox(r, bc.getDefaultCanvasBox())
 	yr = bc.getRanges()
 	if yr.GetMax()-yr.GetMin() == 0 {
 		// return fmt.Errorf("invalid data range; cannot be zero")
@@ -138,6 +177,7 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	bc.drawBars(r, canvasBox, yr)
 	bc.drawXAxis(r, canvasBox)
 	bc.drawYAxis(r, canvasBox, yr, yt)
+	legend.draw(r, bc.getDefaultCanvasBox(), canvasBox)
 
 	bc.drawTitle(r)
 	for _, a := range bc.Elements {
@@ -147,6 +187,13 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// RenderInto renders the chart into a box of an existing renderer.
+func (bc BarChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	bc.Width, bc.Height, bc.DPI = box.Width(), box.Height(), bc.GetDPI(r.GetDPI())
+	return bc.Render(BoxRendererProvider(r, box), nil)
+}
+
 func (bc BarChart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, bc.getCanvasStyle())
 }
@@ -194,6 +241,11 @@ func (bc BarChart) drawBackground(r Renderer) {
 }
 
 func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
+	if bc.IsHorizontal() {
+		bc.drawHorizontalBars(r, canvasBox, yr)
+		return
+	}
+
 	xoffset := canvasBox.Left
 
 	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
@@ -220,7 +272,39 @@ func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 	}
 }
 
+func (bc BarChart) drawHorizontalBars(r Renderer, canvasBox Box, yr Range) {
+	yoffset := canvasBox.Top
+
+	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+	bs2 := spacing >> 1
+
+	var barBox Box
+	var byt, byb, bx int
+	for index, bar := range bc.Bars {
+		byt = yoffset + bs2
+		byb = byt + width
+
+		bx = canvasBox.Left + yr.Translate(bar.Value)
+
+		barBox = Box{
+			Top:    byt,
+			Left:   canvasBox.Left,
+			Right:  bx,
+			Bottom: byb,
+		}
+
+		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
+
+		yoffset += width + spacing
+	}
+}
+
 func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
+	if bc.IsHorizontal() {
+		bc.drawHorizontalXAxis(r, canvasBox)
+		return
+	}
+
 	if bc.XAxis.Show {
 		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -259,7 +343,56 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 	}
 }
 
+// drawHorizontalXAxis draws the bar labels to the left of the canvas, centered on each bar.
+func (bc BarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
+	if bc.XAxis.Show {
+		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
+		labelStyle := bc.XAxis.InheritFrom(bc.styleDefaultsHorizontalLabels())
+		axisStyle.WriteToRenderer(r)
+
+		width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, canvasBox.Top)
+		r.Stroke()
+
+		labelRight := canvasBox.Left - DefaultYAxisMargin
+		labelLeft := util.Math.MaxInt(bc.box().Left, labelRight-bc.getHorizontalLabelWrapWidth())
+
+		cursor := canvasBox.Top
+		for index, bar := range bc.Bars {
+			barLabelBox := Box{
+				Top:    cursor,
+				Left:   labelLeft,
+				Right:  labelRight,
+				Bottom: cursor + width + spacing,
+			}
+
+			if len(bar.Label) > 0 {
+				Draw.TextWithin(r, bar.Label, barLabelBox, labelStyle)
+			}
+
+			axisStyle.WriteToRenderer(r)
+			if index < len(bc.Bars)-1 {
+				r.MoveTo(canvasBox.Left, barLabelBox.Bottom)
+				r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, barLabelBox.Bottom)
+				r.Stroke()
+			}
+			cursor += width + spacing
+		}
+	}
+}
+
 func (bc BarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if bc.IsHorizontal() {
+		bc.drawHorizontalYAxis(r, canvasBox, yr, ticks)
+		return
+	}
+
 	if bc.YAxis.Style.Show {
 		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -290,11 +423,39 @@ func (bc BarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick)
 	}
 }
 
+// drawHorizontalYAxis draws the value ticks along the bottom of the canvas.
+func (bc BarChart) drawHorizontalYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if bc.YAxis.Style.Show {
+		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		var tx int
+		var tb Box
+		for _, t := range ticks {
+			tx = canvasBox.Left + yr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb = r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, tx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height(), axisStyle)
+		}
+	}
+}
+
 func (bc BarChart) drawTitle(r Renderer) {
 	if len(bc.Title) > 0 && bc.TitleStyle.Show {
-		r.SetFont(bc.TitleStyle.GetFont(bc.GetFont()))
-		r.SetFontColor(bc.TitleStyle.GetFontColor(bc.GetColorPalette().TextColor()))
-		titleFontSize := bc.TitleStyle.GetFontSize(bc.getTitleFontSize())
+		titleStyle := bc.TitleStyle.InheritFrom(bc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(bc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(bc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(bc.getTitleFontSize())
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(bc.Title)
@@ -303,7 +464,7 @@ func (bc BarChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (bc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := bc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
 
 		r.Text(bc.Title, titleX, titleY)
 	}
@@ -314,18 +475,23 @@ func (bc BarChart) getCanvasStyle() Style {
 }
 
 func (bc BarChart) styleDefaultsCanvas() Style {
-	return Style{
+	return bc.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   bc.GetColorPalette().CanvasColor(),
 		StrokeColor: bc.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultCanvasStrokeWidth,
-	}
+	})
 }
 
 func (bc BarChart) hasAxes() bool {
-	return bc.YAxis.Style.Show
+	// horizontal bar labels are drawn left of the canvas, so they need room as well.
+	return bc.YAxis.Style.Show || (bc.IsHorizontal() && bc.XAxis.Show)
 }
 
 func (bc BarChart) setRangeDomains(canvasBox Box, yr Range) Range {
+	if bc.IsHorizontal() {
+		yr.SetDomain(canvasBox.Width())
+		return yr
+	}
 	yr.SetDomain(canvasBox.Height())
 	return yr
 }
@@ -342,6 +508,16 @@ func (bc BarChart) getValueFormatters() ValueFormatter {
 }
 
 func (bc BarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks []Tick) {
+	if bc.YAxis.Style.Show && bc.IsHorizontal() && len(bc.YAxis.Ticks) == 0 {
+		// the value ticks run along the bottom, so they have to be spaced by label width.
+		if op, isOriented := yr.(OrientedTicksProvider); isOriented {
+			op.SetVertical(false)
+			return op.GetTicks(r, bc.styleDefaultsAxes(), yf)
+		}
+		if _, isTicksProvider := yr.(TicksProvider); !isTicksProvider {
+			return GenerateContinuousTicks(r, yr, false, bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes()), yf)
+		}
+	}
 	if bc.YAxis.Style.Show {
 		yticks = bc.YAxis.GetTicks(r, yr, bc.styleDefaultsAxes(), yf)
 	}
@@ -350,8 +526,8 @@ func (bc BarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks
 
 func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 	totalWithBaseSpacing := bc.calculateTotalBarWidth(bc.GetBarWidth(), bc.GetBarSpacing())
-	if totalWithBaseSpacing > canvasBox.Width() {
-		lessBarWidths := canvasBox.Width() - (len(bc.Bars) * bc.GetBarWidth())
+	if totalWithBaseSpacing > bc.getCategoryAxisLength(canvasBox) {
+		lessBarWidths := bc.getCategoryAxisLength(canvasBox) - (len(bc.Bars) * bc.GetBarWidth())
 		if lessBarWidths > 0 {
 			return int(math.Ceil(float64(lessBarWidths) / float64(len(bc.Bars))))
 		}
@@ -362,8 +538,8 @@ func (bc BarChart) calculateEffectiveBarSpacing(canvasBox Box) int {
 
 func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
 	totalWithBaseWidth := bc.calculateTotalBarWidth(bc.GetBarWidth(), spacing)
-	if totalWithBaseWidth > canvasBox.Width() {
-		totalLessBarSpacings := canvasBox.Width() - (len(bc.Bars) * spacing)
+	if totalWithBaseWidth > bc.getCategoryAxisLength(canvasBox) {
+		totalLessBarSpacings := bc.getCategoryAxisLength(canvasBox) - (len(bc.Bars) * spacing)
 		if totalLessBarSpacings > 0 {
 			return int(math.Ceil(float64(totalLessBarSpacings) / float64(len(bc.Bars))))
 		}
@@ -372,6 +548,14 @@ func (bc BarChart) calculateEffectiveBarWidth(canvasBox Box, spacing int) int {
 	return bc.GetBarWidth()
 }
 
+// getCategoryAxisLength returns the length of the canvas the bars are laid out along.
+func (bc BarChart) getCategoryAxisLength(canvasBox Box) int {
+	if bc.IsHorizontal() {
+		return canvasBox.Height()
+	}
+	return canvasBox.Width()
+}
+
 func (bc BarChart) calculateTotalBarWidth(barWidth, spacing int) int {
 	return len(bc.Bars) * (barWidth + spacing)
 }
@@ -384,6 +568,10 @@ func (bc BarChart) calculateScaledTotalWidth(canvasBox Box) (width, spacing, tot
 }
 
 func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	if bc.IsHorizontal() {
+		return bc.getAdjustedHorizontalCanvasBox(r, canvasBox, yrange, yticks)
+	}
+
 	axesOuterBox := canvasBox.Clone()
 
 	_, _, totalWidth := bc.calculateScaledTotalWidth(canvasBox)
@@ -425,17 +613,76 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
 
-	return canvasBox.OuterConstrain(bc.box(), axesOuterBox)
+	return canvasBox.OuterConstrain(bc.getLegend().adjustCanvasBox(r, bc.box()), axesOuterBox)
+}
+
+// getHorizontalLabelWrapWidth returns the width bar labels wrap at when the bars are horizontal,
+// they wrap once they would take up more than a third of the chart.
+func (bc BarChart) getHorizontalLabelWrapWidth() int {
+	return bc.box().Width() / 3
+}
+
+func (bc BarChart) getAdjustedHorizontalCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	axesOuterBox := canvasBox.Clone()
+
+	if bc.XAxis.Show {
+		labelStyle := bc.XAxis.InheritFrom(bc.styleDefaultsHorizontalLabels())
+		labelStyle.WriteToRenderer(r)
+
+		var labelWidth int
+		for _, bar := range bc.Bars {
+			if len(bar.Label) > 0 {
+				lines := Text.WrapFit(r, bar.Label, bc.getHorizontalLabelWrapWidth(), labelStyle)
+				linesBox := Text.MeasureLines(r, lines, labelStyle)
+
+				labelWidth = util.Math.MaxInt(linesBox.Width(), labelWidth)
+			}
+		}
+
+		axesOuterBox = axesOuterBox.Grow(Box{
+			Top:    canvasBox.Top,
+			Left:   canvasBox.Left - (labelWidth + DefaultYAxisMargin),
+			Right:  canvasBox.Left,
+			Bottom: canvasBox.Bottom,
+		})
+	}
+
+	if bc.YAxis.Style.Show {
+		axisStyle := bc.YAxis.Style.InheritFrom(bc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		valueAxisBox := Box{
+			Top:    canvasBox.Bottom,
+			Left:   canvasBox.Left,
+			Right:  canvasBox.Right,
+			Bottom: canvasBox.Bottom + DefaultVerticalTickHeight,
+		}
+		for _, t := range yticks {
+			tx := canvasBox.Left + yrange.Translate(t.Value)
+			tb := r.MeasureText(t.Label)
+
+			valueAxisBox = valueAxisBox.Grow(Box{
+				Top:    canvasBox.Bottom,
+				Left:   tx - (tb.Width() >> 1),
+				Right:  tx + (tb.Width() >> 1),
+				Bottom: canvasBox.Bottom + DefaultXAxisMargin + tb.Height(),
+			})
+		}
+		axesOuterBox = axesOuterBox.Grow(valueAxisBox)
+	}
+
+	return canvasBox.OuterConstrain(bc.getLegend().adjustCanvasBox(r, bc.box()), axesOuterBox)
 }
 
 // box returns the chart bounds as a box.
 func (bc BarChart) box() Box {
-	dpr := bc.Background.Padding.GetRight(10)
-	dpb := bc.Background.Padding.GetBottom(50)
+	padding := bc.GetTheme().BackgroundStyle.Padding
+	dpr := bc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := bc.Background.Padding.GetBottom(padding.GetBottom(50))
 
 	return Box{
-		Top:    bc.Background.Padding.GetTop(20),
-		Left:   bc.Background.Padding.GetLeft(20),
+		Top:    bc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   bc.Background.Padding.GetLeft(padding.GetLeft(20)),
 		Right:  bc.GetWidth() - dpr,
 		Bottom: bc.GetHeight() - dpb,
 	}
@@ -446,30 +693,30 @@ func (bc BarChart) getBackgroundStyle() Style {
 }
 
 func (bc BarChart) styleDefaultsBackground() Style {
-	return Style{
+	return bc.GetTheme().BackgroundStyle.InheritFrom(Style{
 		FillColor:   bc.GetColorPalette().BackgroundColor(),
 		StrokeColor: bc.GetColorPalette().BackgroundStrokeColor(),
 		StrokeWidth: DefaultStrokeWidth,
-	}
+	})
 }
 
 func (bc BarChart) styleDefaultsBar(index int) Style {
-	return Style{
+	return bc.GetTheme().SeriesStyle.InheritFrom(Style{
 		StrokeColor: bc.GetColorPalette().GetSeriesColor(index),
 		StrokeWidth: 3.0,
 		FillColor:   bc.GetColorPalette().GetSeriesColor(index),
-	}
+	})
 }
 
 func (bc BarChart) styleDefaultsTitle() Style {
-	return bc.TitleStyle.InheritFrom(Style{
+	return bc.TitleStyle.InheritFrom(bc.GetTheme().TitleStyle.InheritFrom(Style{
 		FontColor:           bc.GetColorPalette().TextColor(),
 		Font:                bc.GetFont(),
 		FontSize:            bc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
-	})
+	}))
 }
 
 func (bc BarChart) getTitleFontSize() float64 {
@@ -487,7 +734,7 @@ func (bc BarChart) getTitleFontSize() float64 {
 }
 
 func (bc BarChart) styleDefaultsAxes() Style {
-	return Style{
+	return bc.GetTheme().inheritAxisStyle(Style{
 		StrokeColor:         bc.GetColorPalette().AxisStrokeColor(),
 		Font:                bc.GetFont(),
 		FontSize:            DefaultAxisFontSize,
@@ -495,7 +742,35 @@ func (bc BarChart) styleDefaultsAxes() Style {
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
+	})
+}
+
+func (bc BarChart) styleDefaultsHorizontalLabels() Style {
+	return bc.GetTheme().inheritAxisStyle(Style{
+		Font:                bc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           bc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignRight,
+		TextVerticalAlign:   TextVerticalAlignMiddle,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for every labeled bar.
+func (bc BarChart) GetLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	for index, bar := range bc.Bars {
+		entries = append(entries, LegendEntry{
+			Label:  bar.Label,
+			Style:  bar.Style.InheritFrom(bc.styleDefaultsBar(index)),
+			Marker: LegendMarkerBox,
+		})
 	}
+	return entries
+}
+
+func (bc BarChart) getLegend() chartLegend {
+	return newChartLegend(bc.Legend, bc, styleDefaultsLegend(bc.GetTheme(), bc.GetFont(), bc.GetColorPalette()))
 }
 
 func (bc BarChart) styleDefaultsElements() Style {
@@ -509,5 +784,16 @@ func (bc BarChart) GetColorPalette() ColorPalette {
 	if bc.ColorPalette != nil {
 		return bc.ColorPalette
 	}
+	if theme := bc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return AlternateColorPalette
 }
+
+// GetTheme returns the theme of the chart or the default theme.
+func (bc BarChart) GetTheme() Theme {
+	if bc.Theme != nil {
+		return *bc.Theme
+	}
+	return DefaultTheme
+}
@@ -3,6 +3,8 @@ package chart
 import (
 	"bytes"
 	"math"
+	"regexp"
+	"strconv"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -34,6 +36,67 @@ func TestBarChartRender(t *testing.T) {
 	assert.NotZero(buf.Len())
 }
 
+func TestBarChartRenderHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BarChart{
+		Width:       1024,
+		Orientation: BarOrientationHorizontal,
+		XAxis:       StyleShow(),
+		YAxis: YAxis{
+			Style: StyleShow(),
+		},
+		Bars: []Value{
+			{Value: 1.0, Label: "A Rather Long Category Label"},
+			{Value: 2.0, Label: "Two"},
+			{Value: 3.0, Label: "Three"},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err := bc.Render(PNG, buf)
+	assert.Nil(err)
+	assert.NotZero(buf.Len())
+}
+
+func TestBarChartHorizontalCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	bc := BarChart{
+		Orientation: BarOrientationHorizontal,
+		Font:        f,
+		XAxis:       StyleShow(),
+		YAxis: YAxis{
+			Style: StyleShow(),
+		},
+		Bars: []Value{
+			{Value: 1.0, Label: "A Rather Long Category Label"},
+			{Value: 2.0, Label: "Two"},
+		},
+	}
+
+	r, err := PNG(bc.GetWidth(), bc.GetHeight())
+	assert.Nil(err)
+
+	cb := bc.getDefaultCanvasBox()
+	yr := bc.setRangeDomains(cb, bc.getRanges())
+	assert.Equal(cb.Width(), yr.GetDomain())
+
+	yt := bc.getAxesTicks(r, yr, bc.getValueFormatters())
+	assert.NotEmpty(yt)
+
+	adjusted := bc.getAdjustedCanvasBox(r, cb, yr, yt)
+	assert.True(adjusted.Left > cb.Left)
+	assert.Equal(cb.Top, adjusted.Top)
+
+	width, spacing, _ := bc.calculateScaledTotalWidth(adjusted)
+	assert.Equal(bc.GetBarWidth(), width)
+	assert.Equal(bc.GetBarSpacing(), spacing)
+}
+
 func TestBarChartRenderZero(t *testing.T) {
 	assert := assert.New(t)
 
@@ -318,3 +381,51 @@ func TestBarChatGetTitleFontSize(t *testing.T) {
 	size = BarChart{Width: 128, Height: 128}.getTitleFontSize()
 	assert.Equal(10, size)
 }
+
+func TestBarChartHorizontalLabelsPadding(t *testing.T) {
+	assert := assert.New(t)
+
+	label := "one two three four five six seven eight nine ten eleven twelve"
+	graphs := []Graph{
+		BarChart{
+			Orientation: BarOrientationHorizontal,
+			Background:  Style{Padding: Box{Left: 150}},
+			XAxis:       StyleShow(),
+			Bars:        []Value{{Value: 1.0, Label: label}, {Value: 2.0, Label: "Two"}},
+		},
+		StackedBarChart{
+			Orientation: BarOrientationHorizontal,
+			Background:  Style{Padding: Box{Left: 150}},
+			XAxis:       StyleShow(),
+			Bars:        []StackedBar{{Name: label, Values: []Value{{Value: 1.0}, {Value: 3.0}}}},
+		},
+	}
+
+	for _, graph := range graphs {
+		buf := bytes.NewBuffer([]byte{})
+		assert.Nil(graph.Render(SVG, buf))
+
+		texts := regexp.MustCompile(`<text x="(-?\d+)"`).FindAllStringSubmatch(buf.String(), -1)
+		assert.NotEmpty(texts)
+		for _, text := range texts {
+			x, err := strconv.Atoi(text[1])
+			assert.Nil(err)
+			assert.True(x >= 150, text[0])
+		}
+	}
+}
+
+func TestBarChartHorizontalNiceRange(t *testing.T) {
+	assert := assert.New(t)
+
+	nr := &NiceRange{Min: 0, Max: 3}
+	bc := BarChart{
+		Orientation: BarOrientationHorizontal,
+		YAxis:       YAxis{Style: StyleShow(), Range: nr},
+		Bars:        []Value{{Value: 1.0, Label: "a"}, {Value: 2.0, Label: "b"}},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(bc.Render(PNG, buf))
+	assert.False(nr.IsVertical)
+}
@@ -0,0 +1,645 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/daill/go-chart/seq"
+	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultBoxPlotBoxWidth is the default width of a box as a fraction of the width of its category.
+	DefaultBoxPlotBoxWidth = 0.5
+	// DefaultBoxPlotDotWidth is the default radius of the outlier and mean dots.
+	DefaultBoxPlotDotWidth = 3.0
+	// DefaultBoxPlotTukeyFactor is the multiple of the interquartile range the tukey whiskers reach past the box.
+	DefaultBoxPlotTukeyFactor = 1.5
+)
+
+// BoxPlotWhiskers is how far the whiskers of a box plot reach.
+type BoxPlotWhiskers int
+
+const (
+	// BoxPlotWhiskersTukey extends the whiskers to the furthest samples within 1.5 times the interquartile
+	// range of the box; samples beyond the whiskers are drawn as outliers.
+	BoxPlotWhiskersTukey BoxPlotWhiskers = 0
+	// BoxPlotWhiskersMinMax extends the whiskers to the smallest and largest samples.
+	BoxPlotWhiskersMinMax BoxPlotWhiskers = 1
+)
+
+// BoxPlotCategory is a labeled set of raw samples drawn as a single box.
+type BoxPlotCategory struct {
+	Label   string
+	Samples []float64
+	Style   Style
+}
+
+// GetStats computes the quartiles, whiskers, mean and outliers of the samples.
+func (bpc BoxPlotCategory) GetStats(whiskers BoxPlotWhiskers) (stats BoxPlotStats) {
+	if len(bpc.Samples) == 0 {
+		return
+	}
+
+	samples := seq.New(seq.NewArray(bpc.Samples...))
+	stats.Q1 = samples.Percentile(0.25)
+	stats.Median = samples.Median()
+	stats.Q3 = samples.Percentile(0.75)
+	stats.Mean = samples.Average()
+
+	min, max := samples.MinMax()
+	if whiskers == BoxPlotWhiskersMinMax {
+		stats.LowerWhisker, stats.UpperWhisker = min, max
+		return
+	}
+
+	iqr := stats.Q3 - stats.Q1
+	lowerFence := stats.Q1 - DefaultBoxPlotTukeyFactor*iqr
+	upperFence := stats.Q3 + DefaultBoxPlotTukeyFactor*iqr
+
+	// the whiskers end at the furthest samples within the fences, but never within the box.
+	stats.LowerWhisker, stats.UpperWhisker = stats.Q1, stats.Q3
+	for _, v := range bpc.Samples {
+		if v < lowerFence || v > upperFence {
+			stats.Outliers = append(stats.Outliers, v)
+			continue
+		}
+		stats.LowerWhisker = math.Min(stats.LowerWhisker, v)
+		stats.UpperWhisker = math.Max(stats.UpperWhisker, v)
+	}
+	return
+}
+
+// BoxPlotStats are the summary statistics a box plot draws for a category.
+type BoxPlotStats struct {
+	LowerWhisker float64
+	Q1           float64
+	Median       float64
+	Q3           float64
+	UpperWhisker float64
+	Mean         float64
+	Outliers     []float64
+}
+
+// BoxPlotChart is a chart that draws the distribution of samples per category as box-and-whisker plots.
+type BoxPlotChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	XAxis Style
+	YAxis YAxis
+
+	// BoxWidth is the width of each box as a fraction of the width of its category.
+	BoxWidth float64
+	// Whiskers sets how far the whiskers reach; the default is `BoxPlotWhiskersTukey`.
+	Whiskers BoxPlotWhiskers
+
+	// ShowMean overlays the mean of each category as a dot.
+	ShowMean  bool
+	MeanStyle Style
+	// OutlierStyle is the style of the outlier dots.
+	OutlierStyle Style
+
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Categories []BoxPlotCategory
+	Elements   []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (bpc BoxPlotChart) GetDPI(defaults ...float64) float64 {
+	if bpc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return bpc.DPI
+}
+
+// GetFont returns the text font.
+func (bpc BoxPlotChart) GetFont() *truetype.Font {
+	if bpc.Font == nil {
+		if theme := bpc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
+		return bpc.defaultFont
+	}
+	return bpc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (bpc BoxPlotChart) GetWidth() int {
+	if bpc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return bpc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (bpc BoxPlotChart) GetHeight() int {
+	if bpc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return bpc.Height
+}
+
+// GetBoxWidth returns the box width as a fraction of the category width, or the default.
+func (bpc BoxPlotChart) GetBoxWidth() float64 {
+	if bpc.BoxWidth == 0 {
+		return DefaultBoxPlotBoxWidth
+	}
+	return bpc.BoxWidth
+}
+
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (bpc BoxPlotChart) Validate() error {
+	if !bpc.hasSamples() {
+		return FieldError{Field: "Categories", Err: errors.New("please provide at least one category with samples")}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (bpc BoxPlotChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := bpc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(bpc.GetWidth(), bpc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if bpc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		bpc.defaultFont = defaultFont
+	}
+	r.SetDPI(bpc.GetDPI())
+
+	bpc.drawBackground(r)
+
+	var canvasBox Box
+	var yt []Tick
+	var yr Range
+	var yf ValueFormatter
+
+	stats := bpc.getStats()
+
+	legend := bpc.getLegend()
+	canvasBox = legend.adjustCanvasBox(r, bpc.box())
+	yr = bpc.getRanges(stats)
+	yr.SetDomain(canvasBox.Height())
+	yf = bpc.getValueFormatters()
+
+	if bpc.YAxis.Style.Show {
+		yt = bpc.YAxis.GetTicks(r, yr, bpc.styleDefaultsAxes(), yf)
+	}
+	if bpc.XAxis.Show || bpc.YAxis.Style.Show {
+		canvasBox = bpc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
+		yr.SetDomain(canvasBox.Height())
+	}
+
+	bpc.drawCanvas(r, canvasBox)
+	bpc.drawBoxes(r, canvasBox, yr, stats)
+	bpc.drawXAxis(r, canvasBox)
+	bpc.drawYAxis(r, canvasBox, yr, yt)
+	legend.draw(r, bpc.box(), canvasBox)
+
+	bpc.drawTitle(r)
+	for _, a := range bpc.Elements {
+		a(r, canvasBox, bpc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// RenderInto renders the chart into a box of an existing renderer.
+func (bpc BoxPlotChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	bpc.Width, bpc.Height, bpc.DPI = box.Width(), box.Height(), bpc.GetDPI(r.GetDPI())
+	return bpc.Render(BoxRendererProvider(r, box), nil)
+}
+
+func (bpc BoxPlotChart) hasSamples() bool {
+	for _, c := range bpc.Categories {
+		if len(c.Samples) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getStats returns the stats for each category.
+func (bpc BoxPlotChart) getStats() []BoxPlotStats {
+	stats := make([]BoxPlotStats, len(bpc.Categories))
+	for index, c := range bpc.Categories {
+		stats[index] = c.GetStats(bpc.Whiskers)
+	}
+	return stats
+}
+
+func (bpc BoxPlotChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  bpc.GetWidth(),
+		Bottom: bpc.GetHeight(),
+	}, bpc.getBackgroundStyle())
+}
+
+func (bpc BoxPlotChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, bpc.getCanvasStyle())
+}
+
+// getRanges returns the value range covering the whiskers and outliers of every category.
+func (bpc BoxPlotChart) getRanges(stats []BoxPlotStats) Range {
+	var yrange Range
+	if bpc.YAxis.Range != nil && !bpc.YAxis.Range.IsZero() {
+		yrange = bpc.YAxis.Range
+	} else {
+		yrange = &ContinuousRange{}
+	}
+
+	if !yrange.IsZero() {
+		return yrange
+	}
+
+	if len(bpc.YAxis.Ticks) > 0 {
+		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
+		for _, t := range bpc.YAxis.Ticks {
+			tickMin = math.Min(tickMin, t.Value)
+			tickMax = math.Max(tickMax, t.Value)
+		}
+		yrange.SetMin(tickMin)
+		yrange.SetMax(tickMax)
+		return yrange
+	}
+
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for index, s := range stats {
+		if len(bpc.Categories[index].Samples) == 0 {
+			continue
+		}
+		min = math.Min(min, s.LowerWhisker)
+		max = math.Max(max, s.UpperWhisker)
+		for _, o := range s.Outliers {
+			min = math.Min(min, o)
+			max = math.Max(max, o)
+		}
+	}
+
+	if min == max {
+		max = min + 1
+	}
+
+	yrange.SetMin(min)
+	yrange.SetMax(max)
+
+	return yrange
+}
+
+func (bpc BoxPlotChart) drawBoxes(r Renderer, canvasBox Box, yr Range, stats []BoxPlotStats) {
+	categoryWidth := bpc.getCategoryWidth(canvasBox)
+	boxWidth := util.Math.MaxInt(1, int(float64(categoryWidth)*bpc.GetBoxWidth()))
+	bw2 := boxWidth >> 1
+	bw4 := boxWidth >> 2
+
+	translate := func(v float64) int {
+		return canvasBox.Bottom - yr.Translate(v)
+	}
+
+	for index, c := range bpc.Categories {
+		if len(c.Samples) == 0 {
+			continue
+		}
+		s := stats[index]
+		style := c.Style.InheritFrom(bpc.styleDefaultsBox(index))
+		x := canvasBox.Left + index*categoryWidth + (categoryWidth >> 1)
+
+		// whiskers and their caps.
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x, translate(s.Q1))
+		r.LineTo(x, translate(s.LowerWhisker))
+		r.MoveTo(x-bw4, translate(s.LowerWhisker))
+		r.LineTo(x+bw4, translate(s.LowerWhisker))
+		r.MoveTo(x, translate(s.Q3))
+		r.LineTo(x, translate(s.UpperWhisker))
+		r.MoveTo(x-bw4, translate(s.UpperWhisker))
+		r.LineTo(x+bw4, translate(s.UpperWhisker))
+		r.Stroke()
+		r.ResetStyle()
+
+		Draw.Box(r, Box{
+			Top:    translate(s.Q3),
+			Left:   x - bw2,
+			Right:  x - bw2 + boxWidth,
+			Bottom: translate(s.Q1),
+		}, style)
+
+		medianStyle := style.GetStrokeOptions()
+		medianStyle.StrokeWidth = 2 * style.GetStrokeWidth()
+		medianStyle.WriteToRenderer(r)
+		r.MoveTo(x-bw2, translate(s.Median))
+		r.LineTo(x-bw2+boxWidth, translate(s.Median))
+		r.Stroke()
+		r.ResetStyle()
+
+		outlierStyle := bpc.OutlierStyle.InheritFrom(bpc.styleDefaultsOutliers(style))
+		for _, o := range s.Outliers {
+			bpc.drawDot(r, x, translate(o), outlierStyle)
+		}
+
+		if bpc.ShowMean {
+			meanStyle := bpc.MeanStyle.InheritFrom(bpc.styleDefaultsMean(style))
+			bpc.drawDot(r, x, translate(s.Mean), meanStyle)
+		}
+	}
+}
+
+func (bpc BoxPlotChart) drawDot(r Renderer, x, y int, style Style) {
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	r.Circle(style.GetDotWidth(), x, y)
+	r.FillStroke()
+}
+
+func (bpc BoxPlotChart) drawXAxis(r Renderer, canvasBox Box) {
+	if bpc.XAxis.Show {
+		axisStyle := bpc.XAxis.InheritFrom(bpc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		categoryWidth := bpc.getCategoryWidth(canvasBox)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom+DefaultVerticalTickHeight)
+		r.Stroke()
+
+		cursor := canvasBox.Left
+		for index, c := range bpc.Categories {
+			labelBox := Box{
+				Top:    canvasBox.Bottom + DefaultXAxisMargin,
+				Left:   cursor,
+				Right:  cursor + categoryWidth,
+				Bottom: bpc.GetHeight(),
+			}
+
+			if len(c.Label) > 0 {
+				Draw.TextWithin(r, c.Label, labelBox, axisStyle)
+			}
+
+			axisStyle.WriteToRenderer(r)
+			if index < len(bpc.Categories)-1 {
+				r.MoveTo(labelBox.Right, canvasBox.Bottom)
+				r.LineTo(labelBox.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
+				r.Stroke()
+			}
+			cursor += categoryWidth
+		}
+	}
+}
+
+func (bpc BoxPlotChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if bpc.YAxis.Style.Show {
+		axisStyle := bpc.YAxis.Style.InheritFrom(bpc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Right, canvasBox.Top)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Right, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, canvasBox.Bottom)
+		r.Stroke()
+
+		var ty int
+		var tb Box
+		for _, t := range ticks {
+			ty = canvasBox.Bottom - yr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(canvasBox.Right, ty)
+			r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, ty)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb = r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, canvasBox.Right+DefaultYAxisMargin+5, ty+(tb.Height()>>1), axisStyle)
+		}
+	}
+}
+
+func (bpc BoxPlotChart) drawTitle(r Renderer) {
+	if len(bpc.Title) > 0 && bpc.TitleStyle.Show {
+		titleStyle := bpc.TitleStyle.InheritFrom(bpc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(bpc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(bpc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(DefaultTitleFontSize)
+		r.SetFontSize(titleFontSize)
+
+		textBox := r.MeasureText(bpc.Title)
+
+		textWidth := textBox.Width()
+		textHeight := textBox.Height()
+
+		titleX := (bpc.GetWidth() >> 1) - (textWidth >> 1)
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+
+		r.Text(bpc.Title, titleX, titleY)
+	}
+}
+
+func (bpc BoxPlotChart) getValueFormatters() ValueFormatter {
+	if bpc.YAxis.ValueFormatter != nil {
+		return bpc.YAxis.ValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+// getCategoryWidth returns the width each category takes up on the canvas.
+func (bpc BoxPlotChart) getCategoryWidth(canvasBox Box) int {
+	if len(bpc.Categories) == 0 {
+		return 0
+	}
+	return canvasBox.Width() / len(bpc.Categories)
+}
+
+func (bpc BoxPlotChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	axesOuterBox := canvasBox.Clone()
+
+	if bpc.XAxis.Show {
+		xaxisHeight := DefaultVerticalTickHeight
+
+		axisStyle := bpc.XAxis.InheritFrom(bpc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		categoryWidth := bpc.getCategoryWidth(canvasBox)
+		for _, c := range bpc.Categories {
+			if len(c.Label) > 0 {
+				lines := Text.WrapFit(r, c.Label, categoryWidth, axisStyle)
+				linesBox := Text.MeasureLines(r, lines, axisStyle)
+
+				xaxisHeight = util.Math.MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+			}
+		}
+
+		axesOuterBox = axesOuterBox.Grow(Box{
+			Top:    canvasBox.Top,
+			Left:   canvasBox.Left,
+			Right:  canvasBox.Right,
+			Bottom: canvasBox.Bottom + xaxisHeight,
+		})
+	}
+
+	if bpc.YAxis.Style.Show {
+		axesBounds := bpc.YAxis.Measure(r, canvasBox, yrange, bpc.styleDefaultsAxes(), yticks)
+		axesOuterBox = axesOuterBox.Grow(axesBounds)
+	}
+
+	return canvasBox.OuterConstrain(bpc.getLegend().adjustCanvasBox(r, bpc.box()), axesOuterBox)
+}
+
+// box returns the chart bounds as a box.
+func (bpc BoxPlotChart) box() Box {
+	padding := bpc.GetTheme().BackgroundStyle.Padding
+	dpr := bpc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := bpc.Background.Padding.GetBottom(padding.GetBottom(50))
+
+	return Box{
+		Top:    bpc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   bpc.Background.Padding.GetLeft(padding.GetLeft(20)),
+		Right:  bpc.GetWidth() - dpr,
+		Bottom: bpc.GetHeight() - dpb,
+	}
+}
+
+func (bpc BoxPlotChart) getCanvasStyle() Style {
+	return bpc.Canvas.InheritFrom(bpc.styleDefaultsCanvas())
+}
+
+func (bpc BoxPlotChart) styleDefaultsCanvas() Style {
+	return bpc.GetTheme().CanvasStyle.InheritFrom(Style{
+		FillColor:   bpc.GetColorPalette().CanvasColor(),
+		StrokeColor: bpc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultCanvasStrokeWidth,
+	})
+}
+
+func (bpc BoxPlotChart) getBackgroundStyle() Style {
+	return bpc.Background.InheritFrom(bpc.styleDefaultsBackground())
+}
+
+func (bpc BoxPlotChart) styleDefaultsBackground() Style {
+	return bpc.GetTheme().BackgroundStyle.InheritFrom(Style{
+		FillColor:   bpc.GetColorPalette().BackgroundColor(),
+		StrokeColor: bpc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+// styleDefaultsBox returns the default style of the box at a given index.
+func (bpc BoxPlotChart) styleDefaultsBox(index int) Style {
+	color := bpc.GetColorPalette().GetSeriesColor(index)
+	return bpc.GetTheme().SeriesStyle.InheritFrom(Style{
+		StrokeColor: color,
+		StrokeWidth: DefaultSeriesLineWidth,
+		FillColor:   color.WithAlpha(64),
+	})
+}
+
+// styleDefaultsOutliers returns the default style of the outlier dots of a box, drawn as rings.
+func (bpc BoxPlotChart) styleDefaultsOutliers(boxStyle Style) Style {
+	return Style{
+		StrokeColor: boxStyle.GetStrokeColor(),
+		StrokeWidth: boxStyle.GetStrokeWidth(),
+		FillColor:   bpc.GetColorPalette().CanvasColor(),
+		DotWidth:    DefaultBoxPlotDotWidth,
+	}
+}
+
+// styleDefaultsMean returns the default style of the mean dot of a box.
+func (bpc BoxPlotChart) styleDefaultsMean(boxStyle Style) Style {
+	return Style{
+		StrokeColor: bpc.GetColorPalette().CanvasColor(),
+		StrokeWidth: boxStyle.GetStrokeWidth(),
+		FillColor:   boxStyle.GetStrokeColor(),
+		DotWidth:    DefaultBoxPlotDotWidth,
+	}
+}
+
+func (bpc BoxPlotChart) styleDefaultsAxes() Style {
+	return bpc.GetTheme().inheritAxisStyle(Style{
+		StrokeColor:         bpc.GetColorPalette().AxisStrokeColor(),
+		Font:                bpc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           bpc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for every labeled category.
+func (bpc BoxPlotChart) GetLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	for index, c := range bpc.Categories {
+		entries = append(entries, LegendEntry{
+			Label:  c.Label,
+			Style:  c.Style.InheritFrom(bpc.styleDefaultsBox(index)),
+			Marker: LegendMarkerBox,
+		})
+	}
+	return entries
+}
+
+func (bpc BoxPlotChart) getLegend() chartLegend {
+	return newChartLegend(bpc.Legend, bpc, styleDefaultsLegend(bpc.GetTheme(), bpc.GetFont(), bpc.GetColorPalette()))
+}
+
+func (bpc BoxPlotChart) styleDefaultsElements() Style {
+	return Style{
+		Font: bpc.GetFont(),
+	}
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (bpc BoxPlotChart) GetColorPalette() ColorPalette {
+	if bpc.ColorPalette != nil {
+		return bpc.ColorPalette
+	}
+	if theme := bpc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
+	return AlternateColorPalette
+}
+
+// GetTheme returns the theme of the chart or the default theme.
+func (bpc BoxPlotChart) GetTheme() Theme {
+	if bpc.Theme != nil {
+		return *bpc.Theme
+	}
+	return DefaultTheme
+}
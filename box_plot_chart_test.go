@@ -0,0 +1,66 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestBoxPlotCategoryStats(t *testing.T) {
+	assert := assert.New(t)
+
+	category := BoxPlotCategory{Samples: []float64{20, 1, 2, 3, 4, 5, 6, 7, 8}}
+
+	stats := category.GetStats(BoxPlotWhiskersTukey)
+	assert.Equal(3.0, stats.Q1)
+	assert.Equal(5.0, stats.Median)
+	assert.Equal(7.0, stats.Q3)
+	assert.Equal(1.0, stats.LowerWhisker)
+	assert.Equal(8.0, stats.UpperWhisker)
+	assert.Equal(56.0/9.0, stats.Mean)
+	assert.Equal([]float64{20}, stats.Outliers)
+
+	stats = category.GetStats(BoxPlotWhiskersMinMax)
+	assert.Equal(1.0, stats.LowerWhisker)
+	assert.Equal(20.0, stats.UpperWhisker)
+	assert.Empty(stats.Outliers)
+
+	assert.Equal(BoxPlotStats{}, BoxPlotCategory{}.GetStats(BoxPlotWhiskersTukey))
+}
+
+func TestBoxPlotChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	bpc := BoxPlotChart{
+		Width:    400,
+		Height:   300,
+		XAxis:    StyleShow(),
+		YAxis:    YAxis{Style: StyleShow()},
+		ShowMean: true,
+		Categories: []BoxPlotCategory{
+			{Label: "a", Samples: []float64{1, 2, 3, 4, 5, 6, 7, 8, 20}},
+			{Label: "b", Samples: []float64{-4, 3, 3.5, 4, 4.2, 5}},
+			{Label: "empty"},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(bpc.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	yr := bpc.getRanges(bpc.getStats())
+	assert.Equal(-4.0, yr.GetMin())
+	assert.Equal(20.0, yr.GetMax())
+}
+
+func TestBoxPlotChartRenderNoSamples(t *testing.T) {
+	assert := assert.New(t)
+
+	bpc := BoxPlotChart{
+		Categories: []BoxPlotCategory{{Label: "empty"}},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.NotNil(bpc.Render(PNG, buffer))
+}
@@ -0,0 +1,74 @@
+package chart
+
+import "io"
+
+// BoxRendererProvider returns a renderer provider that draws into a box of an existing renderer.
+// The provided renderer translates coordinates so (0,0) is the top left corner of the box,
+// and saving it is a no-op so the parent renderer can be saved once everything is drawn.
+// Setting its dpi sets the dpi of the parent renderer, so callers restore it when they're done.
+func BoxRendererProvider(r Renderer, box Box) RendererProvider {
+	return func(_, _ int) (Renderer, error) {
+		br := &boxRenderer{Renderer: r, box: box}
+		if typed, isTyped := r.(InteractiveRenderer); isTyped {
+			return &interactiveBoxRenderer{boxRenderer: br, ir: typed}, nil
+		}
+		return br, nil
+	}
+}
+
+// boxRenderer draws into a box of a parent renderer.
+type boxRenderer struct {
+	Renderer
+	box Box
+}
+
+// MoveTo implements the interface method.
+func (br *boxRenderer) MoveTo(x, y int) {
+	br.Renderer.MoveTo(x+br.box.Left, y+br.box.Top)
+}
+
+// LineTo implements the interface method.
+func (br *boxRenderer) LineTo(x, y int) {
+	br.Renderer.LineTo(x+br.box.Left, y+br.box.Top)
+}
+
+// QuadCurveTo implements the interface method.
+func (br *boxRenderer) QuadCurveTo(cx, cy, x, y int) {
+	br.Renderer.QuadCurveTo(cx+br.box.Left, cy+br.box.Top, x+br.box.Left, y+br.box.Top)
+}
+
+// ArcTo implements the interface method.
+func (br *boxRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	br.Renderer.ArcTo(cx+br.box.Left, cy+br.box.Top, rx, ry, startAngle, delta)
+}
+
+// Circle implements the interface method.
+func (br *boxRenderer) Circle(radius float64, x, y int) {
+	br.Renderer.Circle(radius, x+br.box.Left, y+br.box.Top)
+}
+
+// Text implements the interface method.
+func (br *boxRenderer) Text(body string, x, y int) {
+	br.Renderer.Text(body, x+br.box.Left, y+br.box.Top)
+}
+
+// Save is a no-op, the parent renderer is saved by its owner.
+func (br *boxRenderer) Save(_ io.Writer) error {
+	return nil
+}
+
+// interactiveBoxRenderer draws into a box of a parent interactive renderer.
+type interactiveBoxRenderer struct {
+	*boxRenderer
+	ir InteractiveRenderer
+}
+
+// SetElementInfo implements the interface method.
+func (ibr *interactiveBoxRenderer) SetElementInfo(info ElementInfo) {
+	ibr.ir.SetElementInfo(info)
+}
+
+// ClearElementInfo implements the interface method.
+func (ibr *interactiveBoxRenderer) ClearElementInfo() {
+	ibr.ir.ClearElementInfo()
+}
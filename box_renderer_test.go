@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestBoxRendererTranslates(t *testing.T) {
+	assert := assert.New(t)
+
+	vr, err := SVG(200, 200)
+	assert.Nil(err)
+
+	br, err := BoxRendererProvider(vr, Box{Top: 50, Left: 100, Right: 200, Bottom: 200})(100, 150)
+	assert.Nil(err)
+
+	br.MoveTo(0, 0)
+	br.LineTo(10, 20)
+	br.Stroke()
+	assert.Nil(br.Save(nil))
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(vr.Save(buffer))
+	assert.True(strings.Contains(buffer.String(), "M 100 50\nL 110 70"))
+}
+
+func TestBoxRendererInteractive(t *testing.T) {
+	assert := assert.New(t)
+
+	vr, err := SVGInteractive(100, 100)
+	assert.Nil(err)
+	br, err := BoxRendererProvider(vr, Box{Right: 100, Bottom: 100})(100, 100)
+	assert.Nil(err)
+	_, isInteractive := br.(InteractiveRenderer)
+	assert.True(isInteractive)
+
+	vr, err = SVG(100, 100)
+	assert.Nil(err)
+	br, err = BoxRendererProvider(vr, Box{Right: 100, Bottom: 100})(100, 100)
+	assert.Nil(err)
+	_, isInteractive = br.(InteractiveRenderer)
+	assert.False(isInteractive)
+}
+
+func TestGraphRenderInto(t *testing.T) {
+	assert := assert.New(t)
+
+	graphs := []Graph{
+		Chart{Series: []Series{ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}}},
+		BarChart{Bars: []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}},
+		PieChart{Values: []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}},
+		StackedBarChart{Bars: []StackedBar{{Name: "a", Values: []Value{{Value: 1}, {Value: 2}}}}},
+	}
+
+	r, err := PNG(800, 600)
+	assert.Nil(err)
+	r.SetDPI(72)
+
+	for index, graph := range graphs {
+		box := Box{Top: (index / 2) * 300, Left: (index % 2) * 400}
+		box.Right, box.Bottom = box.Left+400, box.Top+300
+		assert.Nil(graph.RenderInto(r, box))
+	}
+	assert.Equal(72.0, r.GetDPI())
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestGraphRenderIntoRestoresDPI(t *testing.T) {
+	assert := assert.New(t)
+
+	graphs := []Graph{
+		Chart{DPI: 150, Series: []Series{ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}}},
+		BarChart{DPI: 150, Bars: []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}},
+		PieChart{DPI: 150, Values: []Value{{Value: 1, Label: "a"}, {Value: 2, Label: "b"}}},
+		StackedBarChart{DPI: 150, Bars: []StackedBar{{Name: "a", Values: []Value{{Value: 1}, {Value: 2}}}}},
+		HeatmapChart{DPI: 150, Values: [][]float64{{1, 2}, {3, 4}}},
+		Layout{DPI: 150, Panels: []Panel{{Graph: PieChart{Values: []Value{{Value: 1}, {Value: 2}}}}}},
+	}
+
+	r, err := PNG(400, 300)
+	assert.Nil(err)
+	r.SetDPI(72)
+
+	for _, graph := range graphs {
+		assert.Nil(graph.RenderInto(r, Box{Right: 400, Bottom: 300}))
+		assert.Equal(72.0, r.GetDPI())
+	}
+}
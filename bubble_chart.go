@@ -15,6 +15,8 @@ type BubbleChart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -28,6 +30,9 @@ type BubbleChart struct {
 	XAxis XAxis
 	YAxis YAxis
 
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -36,8 +41,11 @@ type BubbleChart struct {
 }
 
 // GetDPI returns the dpi for the chart.
-func (bc BubbleChart) GetDPI() float64 {
+func (bc BubbleChart) GetDPI(defaults ...float64) float64 {
 	if bc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
 		return DefaultDPI
 	}
 	return bc.DPI
@@ -46,6 +54,9 @@ func (bc BubbleChart) GetDPI() float64 {
 // GetFont returns the text font.
 func (bc BubbleChart) GetFont() *truetype.Font {
 	if bc.Font == nil {
+		if theme := bc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return bc.defaultFont
 	}
 	return bc.Font
@@ -74,10 +85,18 @@ func (bc BubbleChart) getBubbleScale() float64 {
 	return bc.BubbleScale
 }
 
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (bc BubbleChart) Validate() error {
+	if len(bc.Bubbles) == 0 {
+		return FieldError{Field: "Bubbles", Err: errors.New("please provide at least one bubble")}
+	}
+	return nil
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (bc BubbleChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(bc.Bubbles) == 0 {
-		return errors.New("please provide at least one bubble")
+	if err := bc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(bc.GetWidth(), bc.GetHeight())
@@ -105,7 +124,8 @@ func (bc BubbleChart) Render(rp RendererProvider, w io.Writer) error {
 	var xr Range
 	var xf ValueFormatter
 
-	canvasBox = bc.getDefaultCanvasBox()
+	legend := bc.getLegend()
+	canvasBox = legend.adjustCanvasBox(r, bc.getDefaultCanvasBox())
 	yr = bc.getYRanges()
 	if yr.GetMax()-yr.GetMin() == 0 {
 		// return fmt.Errorf("invalid data range; cannot be zero")
@@ -151,6 +171,7 @@ func (bc BubbleChart) Render(rp RendererProvider, w io.Writer) error {
 	bc.drawBubbles(r, canvasBox, xr, yr)
 	bc.drawXAxis(r, canvasBox, xr, xt)
 	bc.drawYAxis(r, canvasBox, yr, yt)
+	legend.draw(r, bc.getDefaultCanvasBox(), canvasBox)
 
 	bc.drawTitle(r)
 	for _, a := range bc.Elements {
@@ -160,6 +181,13 @@ func (bc BubbleChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// RenderInto renders the chart into a box of an existing renderer.
+func (bc BubbleChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	bc.Width, bc.Height, bc.DPI = box.Width(), box.Height(), bc.GetDPI(r.GetDPI())
+	return bc.Render(BoxRendererProvider(r, box), nil)
+}
+
 func (bc BubbleChart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, bc.getCanvasStyle())
 }
@@ -241,6 +269,17 @@ func (bc BubbleChart) drawBackground(r Renderer) {
 	}, bc.getBackgroundStyle())
 }
 
+// getSortedBubbles returns the bubbles from largest to smallest, the order they're colored and drawn
+// in so smaller bubbles are drawn over larger ones.
+func (bc BubbleChart) getSortedBubbles() []BubbleValue {
+	sorted := make([]BubbleValue, len(bc.Bubbles))
+	copy(sorted, bc.Bubbles)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value.Value > sorted[j].Value.Value
+	})
+	return sorted
+}
+
 func (bc BubbleChart) drawBubbles(r Renderer, canvasBox Box, xr, yr Range) {
 	xoffset := canvasBox.Left
 	yoffset := canvasBox.Bottom
@@ -249,12 +288,7 @@ func (bc BubbleChart) drawBubbles(r Renderer, canvasBox Box, xr, yr Range) {
 	//var tb Box
 	//var text string
 
-	// sort slice to achieve correct overlay order .. from high to low
-	sort.Slice(bc.Bubbles, func(i, j int) bool {
-		return bc.Bubbles[i].Value.Value > bc.Bubbles[j].Value.Value
-	})
-
- 	for index, bubble := range bc.Bubbles {
+	for index, bubble := range bc.getSortedBubbles() {
 		bubbleBox = Bubble{
 			MidPointX: xoffset+int(xr.Translate(bubble.XVal)),
 			MidPointY: yoffset-int(yr.Translate(bubble.YVal)),
@@ -356,9 +390,10 @@ func (bc BubbleChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tic
 
 func (bc BubbleChart) drawTitle(r Renderer) {
 	if len(bc.Title) > 0 && bc.TitleStyle.Show {
-		r.SetFont(bc.TitleStyle.GetFont(bc.GetFont()))
-		r.SetFontColor(bc.TitleStyle.GetFontColor(bc.GetColorPalette().TextColor()))
-		titleFontSize := bc.TitleStyle.GetFontSize(bc.getTitleFontSize())
+		titleStyle := bc.TitleStyle.InheritFrom(bc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(bc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(bc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(bc.getTitleFontSize())
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(bc.Title)
@@ -367,7 +402,7 @@ func (bc BubbleChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (bc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := bc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
 
 		r.Text(bc.Title, titleX, titleY)
 	}
@@ -378,11 +413,11 @@ func (bc BubbleChart) getCanvasStyle() Style {
 }
 
 func (bc BubbleChart) styleDefaultsCanvas() Style {
-	return Style{
+	return bc.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   bc.GetColorPalette().CanvasColor(),
 		StrokeColor: bc.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultCanvasStrokeWidth,
-	}
+	})
 }
 
 func (bc BubbleChart) hasAxes() bool {
@@ -460,17 +495,18 @@ func (bc BubbleChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, xrange, yr
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
 
-	return canvasBox.OuterConstrain(bc.box(), axesOuterBox)
+	return canvasBox.OuterConstrain(bc.getLegend().adjustCanvasBox(r, bc.box()), axesOuterBox)
 }
 
 // box returns the chart bounds as a box.
 func (bc BubbleChart) box() Box {
-	dpr := bc.Background.Padding.GetRight(10)
-	dpb := bc.Background.Padding.GetBottom(50)
+	padding := bc.GetTheme().BackgroundStyle.Padding
+	dpr := bc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := bc.Background.Padding.GetBottom(padding.GetBottom(50))
 
 	return Box{
-		Top:    bc.Background.Padding.GetTop(20),
-		Left:   bc.Background.Padding.GetLeft(20),
+		Top:    bc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   bc.Background.Padding.GetLeft(padding.GetLeft(20)),
 		Right:  bc.GetWidth() - dpr,
 		Bottom: bc.GetHeight() - dpb,
 	}
@@ -481,30 +517,30 @@ func (bc BubbleChart) getBackgroundStyle() Style {
 }
 
 func (bc BubbleChart) styleDefaultsBackground() Style {
-	return Style{
+	return bc.GetTheme().BackgroundStyle.InheritFrom(Style{
 		FillColor:   bc.GetColorPalette().BackgroundColor(),
 		StrokeColor: bc.GetColorPalette().BackgroundStrokeColor(),
 		StrokeWidth: DefaultStrokeWidth,
-	}
+	})
 }
 
 func (bc BubbleChart) styleDefaultsBar(index int) Style {
-	return Style{
+	return bc.GetTheme().SeriesStyle.InheritFrom(Style{
 		StrokeColor: bc.GetColorPalette().GetSeriesColor(index),
 		StrokeWidth: 3.0,
 		FillColor:   bc.GetColorPalette().GetSeriesColor(index),
-	}
+	})
 }
 
 func (bc BubbleChart) styleDefaultsTitle() Style {
-	return bc.TitleStyle.InheritFrom(Style{
+	return bc.TitleStyle.InheritFrom(bc.GetTheme().TitleStyle.InheritFrom(Style{
 		FontColor:           bc.GetColorPalette().TextColor(),
 		Font:                bc.GetFont(),
 		FontSize:            bc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
-	})
+	}))
 }
 
 func (bc BubbleChart) getTitleFontSize() float64 {
@@ -522,7 +558,7 @@ func (bc BubbleChart) getTitleFontSize() float64 {
 }
 
 func (bc BubbleChart) styleDefaultsAxes() Style {
-	return Style{
+	return bc.GetTheme().inheritAxisStyle(Style{
 		StrokeColor:         bc.GetColorPalette().AxisStrokeColor(),
 		Font:                bc.GetFont(),
 		FontSize:            DefaultAxisFontSize,
@@ -530,7 +566,26 @@ func (bc BubbleChart) styleDefaultsAxes() Style {
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for every labeled bubble, from largest to smallest.
+func (bc BubbleChart) GetLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	for index, bubble := range bc.getSortedBubbles() {
+		style := bubble.Value.Style.InheritFrom(bc.styleDefaultsBar(index))
+		style.DotColor = style.GetDotColor(style.GetFillColor())
+		entries = append(entries, LegendEntry{
+			Label:  bubble.Value.Label,
+			Style:  style,
+			Marker: LegendMarkerDot,
+		})
 	}
+	return entries
+}
+
+func (bc BubbleChart) getLegend() chartLegend {
+	return newChartLegend(bc.Legend, bc, styleDefaultsLegend(bc.GetTheme(), bc.GetFont(), bc.GetColorPalette()))
 }
 
 func (bc BubbleChart) styleDefaultsElements() Style {
@@ -544,5 +599,16 @@ func (bc BubbleChart) GetColorPalette() ColorPalette {
 	if bc.ColorPalette != nil {
 		return bc.ColorPalette
 	}
+	if theme := bc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return AlternateColorPalette
 }
+
+// GetTheme returns the theme of the chart or the default theme.
+func (bc BubbleChart) GetTheme() Theme {
+	if bc.Theme != nil {
+		return *bc.Theme
+	}
+	return DefaultTheme
+}
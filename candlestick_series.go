@@ -0,0 +1,198 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	util "github.com/daill/go-chart/util"
+)
+
+const (
+	// DefaultCandlestickBodyWidth is the default width of a candle body as a ratio of the
+	// distance between two candles.
+	DefaultCandlestickBodyWidth = 0.7
+)
+
+// CandlestickMode is an enumeration of ways to draw a candlestick series.
+type CandlestickMode int
+
+const (
+	// CandlestickModeCandle draws a filled body between open and close with wicks to the high and low.
+	CandlestickModeCandle CandlestickMode = 0
+	// CandlestickModeOHLC draws a vertical bar from low to high with a tick left for the open and right for the close.
+	CandlestickModeOHLC CandlestickMode = 1
+)
+
+// CandleValue is the open, high, low and close of a period.
+type CandleValue struct {
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+}
+
+// IsUp returns if the candle closed at or above its open.
+func (cv CandleValue) IsUp() bool {
+	return cv.Close >= cv.Open
+}
+
+// CandlestickSeries draws price bars (candles or OHLC bars) for a set of periods.
+// It is a `ValuesProvider` of the close values, so it can be used as the `InnerSeries`
+// of indicator series like `SMASeries`, `BollingerBandsSeries` or `MACDSeries`.
+type CandlestickSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// UpStyle is applied to candles that closed at or above their open.
+	UpStyle Style
+	// DownStyle is applied to candles that closed below their open.
+	DownStyle Style
+
+	Mode CandlestickMode
+
+	// BodyWidth is the width of a candle (or OHLC tick pair) as a ratio (0,1] of the
+	// distance between two adjacent candles.
+	BodyWidth float64
+
+	Candles []CandleValue
+}
+
+// GetName returns the name of the series.
+func (cs CandlestickSeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the series style.
+func (cs CandlestickSeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs CandlestickSeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// GetBodyWidth returns the body width ratio or the default.
+func (cs CandlestickSeries) GetBodyWidth() float64 {
+	if cs.BodyWidth <= 0 || cs.BodyWidth > 1 {
+		return DefaultCandlestickBodyWidth
+	}
+	return cs.BodyWidth
+}
+
+// Len returns the number of candles in the series.
+func (cs CandlestickSeries) Len() int {
+	return len(cs.Candles)
+}
+
+// GetValues gets the x value and the close at a given index.
+func (cs CandlestickSeries) GetValues(index int) (x, y float64) {
+	x = util.Time.ToFloat64(cs.Candles[index].Timestamp)
+	y = cs.Candles[index].Close
+	return
+}
+
+// GetLastValues gets the last x value and close.
+func (cs CandlestickSeries) GetLastValues() (x, y float64) {
+	return cs.GetValues(len(cs.Candles) - 1)
+}
+
+// GetBoundedValues gets the x value, the high and the low at a given index.
+func (cs CandlestickSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x = util.Time.ToFloat64(cs.Candles[index].Timestamp)
+	y1 = cs.Candles[index].High
+	y2 = cs.Candles[index].Low
+	return
+}
+
+// GetBoundedLastValues gets the last x value, high and low.
+func (cs CandlestickSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	return cs.GetBoundedValues(len(cs.Candles) - 1)
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (cs CandlestickSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = TimeValueFormatter
+	y = FloatValueFormatter
+	return
+}
+
+// Render renders the series.
+func (cs CandlestickSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(cs.Candles) == 0 {
+		return
+	}
+
+	upStyle := cs.UpStyle.InheritFrom(cs.Style.InheritFrom(cs.styleDefaultsUp().InheritFrom(defaults)))
+	downStyle := cs.DownStyle.InheritFrom(cs.Style.InheritFrom(cs.styleDefaultsDown().InheritFrom(defaults)))
+
+	width := cs.getBodyPixelWidth(xrange)
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+
+	for _, cv := range cs.Candles {
+		style := downStyle
+		if cv.IsUp() {
+			style = upStyle
+		}
+
+		x := cl + xrange.Translate(util.Time.ToFloat64(cv.Timestamp))
+		yo := cb - yrange.Translate(cv.Open)
+		yh := cb - yrange.Translate(cv.High)
+		yl := cb - yrange.Translate(cv.Low)
+		yc := cb - yrange.Translate(cv.Close)
+
+		Draw.valueElementInfo(r, cs, util.Time.ToFloat64(cv.Timestamp), cv.Close)
+		if cs.Mode == CandlestickModeOHLC {
+			Draw.OHLCBar(r, x, width, yo, yh, yl, yc, style)
+		} else {
+			Draw.Candlestick(r, x, width, yo, yh, yl, yc, style)
+		}
+	}
+	Draw.clearElementInfo(r)
+}
+
+// getBodyPixelWidth returns the body width in pixels based on the smallest distance between two candles.
+func (cs CandlestickSeries) getBodyPixelWidth(xrange Range) int {
+	spacing := xrange.GetDomain()
+	for index := 1; index < len(cs.Candles); index++ {
+		x0 := xrange.Translate(util.Time.ToFloat64(cs.Candles[index-1].Timestamp))
+		x1 := xrange.Translate(util.Time.ToFloat64(cs.Candles[index].Timestamp))
+		if delta := util.Math.AbsInt(x1 - x0); delta > 0 {
+			spacing = util.Math.MinInt(spacing, delta)
+		}
+	}
+	return util.Math.MaxInt(1, int(math.Floor(float64(spacing)*cs.GetBodyWidth())))
+}
+
+func (cs CandlestickSeries) styleDefaultsUp() Style {
+	return Style{
+		StrokeColor: ColorGreen,
+		StrokeWidth: 1.0,
+		FillColor:   ColorGreen,
+	}
+}
+
+func (cs CandlestickSeries) styleDefaultsDown() Style {
+	return Style{
+		StrokeColor: ColorRed,
+		StrokeWidth: 1.0,
+		FillColor:   ColorRed,
+	}
+}
+
+// Validate validates the series.
+func (cs CandlestickSeries) Validate() error {
+	if len(cs.Candles) == 0 {
+		return fmt.Errorf("candlestick series must have candles set")
+	}
+	for index, cv := range cs.Candles {
+		if cv.High < cv.Low {
+			return fmt.Errorf("candlestick series candle %d has a high below its low", index)
+		}
+	}
+	return nil
+}
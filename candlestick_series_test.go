@@ -0,0 +1,111 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/util"
+)
+
+func TestCandlestickSeriesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2018, 01, 02, 0, 0, 0, 0, time.UTC)
+	cs := CandlestickSeries{
+		Candles: []CandleValue{
+			{Timestamp: start, Open: 10, High: 12, Low: 9, Close: 11},
+			{Timestamp: start.AddDate(0, 0, 1), Open: 11, High: 11.5, Low: 8, Close: 8.5},
+			{Timestamp: start.AddDate(0, 0, 2), Open: 8.5, High: 10, Low: 8.5, Close: 9.5},
+			{Timestamp: start.AddDate(0, 0, 3), Open: 9.5, High: 13, Low: 9, Close: 12.5},
+		},
+	}
+	assert.Equal(4, cs.Len())
+
+	x, y := cs.GetValues(1)
+	assert.Equal(util.Time.ToFloat64(cs.Candles[1].Timestamp), x)
+	assert.Equal(8.5, y)
+
+	_, y1, y2 := cs.GetBoundedValues(3)
+	assert.Equal(13.0, y1)
+	assert.Equal(9.0, y2)
+
+	_, ly := cs.GetLastValues()
+	assert.Equal(12.5, ly)
+
+	assert.True(cs.Candles[0].IsUp())
+	assert.False(cs.Candles[1].IsUp())
+}
+
+func TestCandlestickSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(CandlestickSeries{}.Validate())
+	start := time.Date(2018, 01, 02, 0, 0, 0, 0, time.UTC)
+	cs := CandlestickSeries{
+		Candles: []CandleValue{
+			{Timestamp: start, Open: 10, High: 12, Low: 9, Close: 11},
+			{Timestamp: start.AddDate(0, 0, 1), Open: 11, High: 11.5, Low: 8, Close: 8.5},
+		},
+	}
+	assert.Nil(cs.Validate())
+
+	cs.Candles[1].High = 1
+	assert.NotNil(cs.Validate())
+}
+
+func TestCandlestickSeriesBodyWidth(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2018, 01, 02, 0, 0, 0, 0, time.UTC)
+	cs := CandlestickSeries{
+		Candles: []CandleValue{
+			{Timestamp: start, Open: 10, High: 12, Low: 9, Close: 11},
+			{Timestamp: start.AddDate(0, 0, 1), Open: 11, High: 11.5, Low: 8, Close: 8.5},
+			{Timestamp: start.AddDate(0, 0, 2), Open: 8.5, High: 10, Low: 8.5, Close: 9.5},
+			{Timestamp: start.AddDate(0, 0, 3), Open: 9.5, High: 13, Low: 9, Close: 12.5},
+		},
+	}
+	assert.Equal(DefaultCandlestickBodyWidth, cs.GetBodyWidth())
+
+	xrange := &ContinuousRange{Domain: 300}
+	xrange.SetMin(util.Time.ToFloat64(cs.Candles[0].Timestamp))
+	xrange.SetMax(util.Time.ToFloat64(cs.Candles[3].Timestamp))
+	assert.Equal(70, cs.getBodyPixelWidth(xrange))
+
+	cs.BodyWidth = 0.5
+	assert.Equal(50, cs.getBodyPixelWidth(xrange))
+}
+
+func TestCandlestickSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2018, 01, 02, 0, 0, 0, 0, time.UTC)
+	candles := CandlestickSeries{
+		Candles: []CandleValue{
+			{Timestamp: start, Open: 10, High: 12, Low: 9, Close: 11},
+			{Timestamp: start.AddDate(0, 0, 1), Open: 11, High: 11.5, Low: 8, Close: 8.5},
+			{Timestamp: start.AddDate(0, 0, 2), Open: 8.5, High: 10, Low: 8.5, Close: 9.5},
+			{Timestamp: start.AddDate(0, 0, 3), Open: 9.5, High: 13, Low: 9, Close: 12.5},
+		},
+	}
+	ohlc := CandlestickSeries{Candles: candles.Candles, Mode: CandlestickModeOHLC, YAxis: YAxisSecondary}
+
+	c := Chart{
+		Series: []Series{
+			candles,
+			ohlc,
+			SMASeries{InnerSeries: candles, Period: 2},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	xr, yr, _ := c.getRanges()
+	assert.Equal(util.Time.ToFloat64(candles.Candles[0].Timestamp), xr.GetMin())
+	assert.Equal(8.0, yr.GetMin())
+	assert.Equal(13.0, yr.GetMax())
+}
@@ -16,6 +16,8 @@ type Chart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -28,11 +30,16 @@ type Chart struct {
 	YAxis          YAxis
 	YAxisSecondary YAxis
 
+	// Legend is a legend of the series, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
 	Series   []Series
 	Elements []Renderable
+
+	canvasBox Box
 }
 
 // GetDPI returns the dpi for the chart.
@@ -49,6 +56,9 @@ func (c Chart) GetDPI(defaults ...float64) float64 {
 // GetFont returns the text font.
 func (c Chart) GetFont() *truetype.Font {
 	if c.Font == nil {
+		if theme := c.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return c.defaultFont
 	}
 	return c.Font
@@ -70,13 +80,21 @@ func (c Chart) GetHeight() int {
 	return c.Height
 }
 
-// Render renders the chart with the given renderer to the given io.Writer.
-func (c Chart) Render(rp RendererProvider, w io.Writer) error {
+// Validate validates the chart and its series, returning FieldErrors that point at every offending series.
+func (c Chart) Validate() error {
 	if len(c.Series) == 0 {
-		return errors.New("please provide at least one series")
+		return FieldError{Field: "Series", Err: errors.New("please provide at least one series")}
 	}
 	if visibleSeriesErr := c.checkHasVisibleSeries(); visibleSeriesErr != nil {
-		return visibleSeriesErr
+		return FieldError{Field: "Series", Err: visibleSeriesErr}
+	}
+	return c.validateSeries()
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (c Chart) Render(rp RendererProvider, w io.Writer) error {
+	if err := c.Validate(); err != nil {
+		return err
 	}
 
 	c.YAxisSecondary.AxisType = YAxisSecondary
@@ -97,17 +115,46 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 
 	c.drawBackground(r)
 
-	var xt, yt, yta []Tick
-	xr, yr, yra := c.getRanges()
-	canvasBox := c.getDefaultCanvasBox()
+	canvasBox, xr, yr, yra, xt, yt, yta, err := c.layout(r)
+	if err != nil {
+		r.Save(w)
+		return err
+	}
+
+	c.drawCanvas(r, canvasBox)
+	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	for index, series := range c.Series {
+		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+	}
+	c.getLegend().draw(r, c.getDefaultCanvasBox(), canvasBox)
+
+	c.drawTitle(r)
+
+	for _, a := range c.Elements {
+		a(r, canvasBox, c.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// RenderInto renders the chart into a box of an existing renderer.
+func (c Chart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	c.Width, c.Height, c.DPI = box.Width(), box.Height(), c.GetDPI(r.GetDPI())
+	return c.Render(BoxRendererProvider(r, box), nil)
+}
+
+// layout returns the canvas box, ranges and ticks of the chart.
+func (c Chart) layout(r Renderer) (canvasBox Box, xr, yr, yra Range, xt, yt, yta []Tick, err error) {
+	xr, yr, yra = c.getRanges()
+	canvasBox = c.getLegend().adjustCanvasBox(r, c.getDefaultCanvasBox())
 	xf, yf, yfa := c.getValueFormatters()
 
 	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 
 	err = c.checkRanges(xr, yr, yra)
 	if err != nil {
-		r.Save(w)
-		return err
+		return
 	}
 
 	if c.hasAxes() {
@@ -127,19 +174,15 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
 	}
 
-	c.drawCanvas(r, canvasBox)
-	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
-	for index, series := range c.Series {
-		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
-	}
-
-	c.drawTitle(r)
-
-	for _, a := range c.Elements {
-		a(r, canvasBox, c.styleDefaultsElements())
+	// a layout can fix the canvas box to align it with the other panels.
+	if !c.canvasBox.IsZero() {
+		canvasBox = c.canvasBox
+		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		if c.hasAxes() || c.hasAnnotationSeries() {
+			xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		}
 	}
-
-	return r.Save(w)
+	return
 }
 
 func (c Chart) checkHasVisibleSeries() error {
@@ -156,13 +199,15 @@ func (c Chart) checkHasVisibleSeries() error {
 }
 
 func (c Chart) validateSeries() error {
-	var err error
-	for _, s := range c.Series {
-		err = s.Validate()
-		if err != nil {
-			return err
+	var fieldErrors FieldErrors
+	for index, s := range c.Series {
+		if err := s.Validate(); err != nil {
+			fieldErrors = append(fieldErrors, FieldError{Field: fmt.Sprintf("Series[%d]", index), Err: err})
 		}
 	}
+	if len(fieldErrors) > 0 {
+		return fieldErrors
+	}
 	return nil
 }
 
@@ -200,19 +245,26 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 					}
 				}
 			} else if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+				var eb ErrorBars
+				if ebp, isErrorBarsProvider := s.(ErrorBarsProvider); isErrorBarsProvider {
+					eb = ebp.GetErrorBars()
+				}
+
 				seriesLength := vp.Len()
 				for index := 0; index < seriesLength; index++ {
 					vx, vy := vp.GetValues(index)
+					xLower, xUpper := eb.GetXErrors(index)
+					yLower, yUpper := eb.GetYErrors(index)
 
-					minx = math.Min(minx, vx)
-					maxx = math.Max(maxx, vx)
+					minx = math.Min(minx, vx-xLower)
+					maxx = math.Max(maxx, vx+xUpper)
 
 					if seriesAxis == YAxisPrimary {
-						miny = math.Min(miny, vy)
-						maxy = math.Max(maxy, vy)
+						miny = math.Min(miny, vy-yLower)
+						maxy = math.Max(maxy, vy+yUpper)
 					} else if seriesAxis == YAxisSecondary {
-						minya = math.Min(minya, vy)
-						maxya = math.Max(maxya, vy)
+						minya = math.Min(minya, vy-yLower)
+						maxya = math.Max(maxya, vy+yUpper)
 						seriesMappedToSecondaryAxis = true
 					}
 				}
@@ -263,8 +315,8 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		yrange.SetMin(miny)
 		yrange.SetMax(maxy)
 
-		// only round if we're showing the axis
-		if c.YAxis.Style.Show {
+		// only round if we're showing the axis, and never round logarithmic or nice ranges linearly.
+		if c.YAxis.Style.Show && !isLogarithmicRange(yrange) && !isNiceRange(yrange) {
 			delta := yrange.GetDelta()
 			roundTo := util.Math.GetRoundToForDelta(delta)
 			rmin, rmax := util.Math.RoundDown(yrange.GetMin(), roundTo), util.Math.RoundUp(yrange.GetMax(), roundTo)
@@ -286,7 +338,7 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		yrangeAlt.SetMin(minya)
 		yrangeAlt.SetMax(maxya)
 
-		if c.YAxisSecondary.Style.Show {
+		if c.YAxisSecondary.Style.Show && !isLogarithmicRange(yrangeAlt) && !isNiceRange(yrangeAlt) {
 			delta := yrangeAlt.GetDelta()
 			roundTo := util.Math.GetRoundToForDelta(delta)
 			rmin, rmax := util.Math.RoundDown(yrangeAlt.GetMin(), roundTo), util.Math.RoundUp(yrangeAlt.GetMax(), roundTo)
@@ -392,7 +444,7 @@ func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra R
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
 
-	return canvasBox.OuterConstrain(c.Box(), axesOuterBox)
+	return canvasBox.OuterConstrain(c.getLegend().adjustCanvasBox(r, c.Box()), axesOuterBox)
 }
 
 func (c Chart) setRangeDomains(canvasBox Box, xr, yr, yra Range) (Range, Range, Range) {
@@ -440,7 +492,7 @@ func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr,
 		}
 	}
 
-	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+	return canvasBox.OuterConstrain(c.getLegend().adjustCanvasBox(r, c.Box()), annotationSeriesBox)
 }
 
 func (c Chart) getBackgroundStyle() Style {
@@ -463,18 +515,28 @@ func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
 }
 
 func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
+	theme := c.GetTheme()
 	if c.XAxis.Style.Show {
-		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
+		xa := c.XAxis
+		xa.GridMajorStyle, xa.GridMinorStyle = theme.inheritGridStyles(xa.GridMajorStyle, xa.GridMinorStyle)
+		xa.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
 	}
 	if c.YAxis.Style.Show {
-		c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
+		ya := c.YAxis
+		ya.GridMajorStyle, ya.GridMinorStyle = theme.inheritGridStyles(ya.GridMajorStyle, ya.GridMinorStyle)
+		ya.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
 	}
 	if c.YAxisSecondary.Style.Show {
-		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
+		ya := c.YAxisSecondary
+		ya.GridMajorStyle, ya.GridMinorStyle = theme.inheritGridStyles(ya.GridMajorStyle, ya.GridMinorStyle)
+		ya.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
 	}
 }
 
 func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int) {
+	if typed, isTyped := s.(ColorPaletteSeries); isTyped {
+		s = typed.WithDefaultColorPalette(c.GetColorPalette())
+	}
 	if s.GetStyle().IsZero() || s.GetStyle().Show {
 		if s.GetYAxis() == YAxisPrimary {
 			s.Render(r, canvasBox, xrange, yrange, c.styleDefaultsSeries(seriesIndex))
@@ -484,11 +546,37 @@ func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt R
 	}
 }
 
+// GetLegendEntries returns an entry for every shown series except annotations.
+func (c Chart) GetLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	for index, s := range c.Series {
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			continue
+		}
+		if !(s.GetStyle().IsZero() || s.GetStyle().Show) {
+			continue
+		}
+
+		style := s.GetStyle().InheritFrom(c.styleDefaultsSeries(index))
+		marker := LegendMarkerLine
+		if style.ShouldDrawDot() && !style.ShouldDrawStroke() {
+			marker = LegendMarkerDot
+		}
+		entries = append(entries, LegendEntry{Label: s.GetName(), Style: style, Marker: marker})
+	}
+	return entries
+}
+
+func (c Chart) getLegend() chartLegend {
+	return newChartLegend(c.Legend, c, styleDefaultsLegend(c.GetTheme(), c.GetFont(), c.GetColorPalette()))
+}
+
 func (c Chart) drawTitle(r Renderer) {
 	if len(c.Title) > 0 && c.TitleStyle.Show {
-		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
-		r.SetFontColor(c.TitleStyle.GetFontColor(c.GetColorPalette().TextColor()))
-		titleFontSize := c.TitleStyle.GetFontSize(DefaultTitleFontSize)
+		titleStyle := c.TitleStyle.InheritFrom(c.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(c.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(c.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(DefaultTitleFontSize)
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(c.Title)
@@ -497,46 +585,46 @@ func (c Chart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (c.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := c.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
 
 		r.Text(c.Title, titleX, titleY)
 	}
 }
 
 func (c Chart) styleDefaultsBackground() Style {
-	return Style{
+	return c.GetTheme().BackgroundStyle.InheritFrom(Style{
 		FillColor:   c.GetColorPalette().BackgroundColor(),
 		StrokeColor: c.GetColorPalette().BackgroundStrokeColor(),
 		StrokeWidth: DefaultBackgroundStrokeWidth,
-	}
+	})
 }
 
 func (c Chart) styleDefaultsCanvas() Style {
-	return Style{
+	return c.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   c.GetColorPalette().CanvasColor(),
 		StrokeColor: c.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultCanvasStrokeWidth,
-	}
+	})
 }
 
 func (c Chart) styleDefaultsSeries(seriesIndex int) Style {
-	return Style{
+	return c.GetTheme().SeriesStyle.InheritFrom(Style{
 		DotColor:    c.GetColorPalette().GetSeriesColor(seriesIndex),
 		StrokeColor: c.GetColorPalette().GetSeriesColor(seriesIndex),
 		StrokeWidth: DefaultSeriesLineWidth,
 		Font:        c.GetFont(),
 		FontSize:    DefaultFontSize,
-	}
+	})
 }
 
 func (c Chart) styleDefaultsAxes() Style {
-	return Style{
+	return c.GetTheme().inheritAxisStyle(Style{
 		Font:        c.GetFont(),
 		FontColor:   c.GetColorPalette().TextColor(),
 		FontSize:    DefaultAxisFontSize,
 		StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 		StrokeWidth: DefaultAxisLineWidth,
-	}
+	})
 }
 
 func (c Chart) styleDefaultsElements() Style {
@@ -550,17 +638,29 @@ func (c Chart) GetColorPalette() ColorPalette {
 	if c.ColorPalette != nil {
 		return c.ColorPalette
 	}
+	if theme := c.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return DefaultColorPalette
 }
 
+// GetTheme returns the theme of the chart or the default theme.
+func (c Chart) GetTheme() Theme {
+	if c.Theme != nil {
+		return *c.Theme
+	}
+	return DefaultTheme
+}
+
 // Box returns the chart bounds as a box.
 func (c Chart) Box() Box {
-	dpr := c.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+	padding := c.GetTheme().BackgroundStyle.Padding
+	dpr := c.Background.Padding.GetRight(padding.GetRight(DefaultBackgroundPadding.Right))
+	dpb := c.Background.Padding.GetBottom(padding.GetBottom(DefaultBackgroundPadding.Bottom))
 
 	return Box{
-		Top:    c.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   c.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Top:    c.Background.Padding.GetTop(padding.GetTop(DefaultBackgroundPadding.Top)),
+		Left:   c.Background.Padding.GetLeft(padding.GetLeft(DefaultBackgroundPadding.Left)),
 		Right:  c.GetWidth() - dpr,
 		Bottom: c.GetHeight() - dpb,
 	}
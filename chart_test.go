@@ -427,6 +427,37 @@ func TestChartValidatesSeries(t *testing.T) {
 	assert.NotNil(c.validateSeries())
 }
 
+func TestChartValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	err := Chart{}.Validate()
+	assert.NotNil(err)
+	fieldError, isFieldError := err.(FieldError)
+	assert.True(isFieldError)
+	assert.Equal("Series", fieldError.Field)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: seq.Range(1.0, 10.0),
+				YValues: seq.Range(1.0, 10.0),
+			},
+			ContinuousSeries{
+				XValues: seq.Range(1.0, 10.0),
+				YValues: seq.Range(1.0, 5.0),
+			},
+		},
+	}
+
+	err = c.Validate()
+	assert.NotNil(err)
+	fieldErrors, isFieldErrors := err.(FieldErrors)
+	assert.True(isFieldErrors)
+	assert.Len(1, fieldErrors)
+	assert.Equal("Series[1]", fieldErrors[0].Field)
+	assert.NotNil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
 func TestChartCheckRanges(t *testing.T) {
 	assert := assert.New(t)
 
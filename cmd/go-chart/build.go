@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daill/go-chart/spec"
+)
+
+const (
+	// TypeLine draws a series for every y column.
+	TypeLine = "line"
+	// TypeBar draws a bar for every row.
+	TypeBar = "bar"
+	// TypePie draws a slice for every row.
+	TypePie = "pie"
+	// TypeStacked draws a stacked bar for every row with a segment for every y column.
+	TypeStacked = "stacked"
+	// TypeBubble draws a bubble for every row sized by the size column.
+	TypeBubble = "bubble"
+)
+
+// Options are the options that map a table onto a chart.
+type Options struct {
+	Type   string
+	Title  string
+	Width  int
+	Height int
+
+	X    string
+	Y    []string
+	Size string
+
+	Time       bool
+	TimeFormat string
+
+	Orientation string
+}
+
+// GetTimeFormat returns the time layout or a default.
+func (o Options) GetTimeFormat() string {
+	if o.TimeFormat == "" {
+		return time.RFC3339
+	}
+	return o.TimeFormat
+}
+
+// BuildSpec maps the columns of a table onto a chart spec.
+func BuildSpec(t *Table, o Options) (*spec.Chart, error) {
+	x, y, size, err := resolveColumns(t, o)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &spec.Chart{
+		Title:       o.Title,
+		Width:       o.Width,
+		Height:      o.Height,
+		Orientation: o.Orientation,
+	}
+
+	switch o.Type {
+	case TypeLine, "":
+		c.Type = spec.TypeLine
+		c.XAxis = &spec.Axis{Name: t.Columns[x]}
+		c.YAxis = &spec.Axis{}
+		if o.Time {
+			c.XAxis.Formatter = spec.FormatterTime
+			c.XAxis.Format = o.TimeFormat
+		}
+		for _, column := range y {
+			series := spec.Series{Name: t.Columns[column]}
+			if series.YValues, err = t.Floats(column); err != nil {
+				return nil, err
+			}
+			if o.Time {
+				series.Type = spec.SeriesTime
+				series.Times = t.Strings(x)
+				series.TimeFormat = o.GetTimeFormat()
+			} else if series.XValues, err = t.Floats(x); err != nil {
+				return nil, err
+			}
+			c.Series = append(c.Series, series)
+		}
+	case TypeBar, TypePie:
+		if len(y) != 1 {
+			return nil, fmt.Errorf("a %s chart takes exactly one y column, got %d", o.Type, len(y))
+		}
+		c.Type = spec.TypeBar
+		if o.Type == TypePie {
+			c.Type = spec.TypePie
+		} else {
+			c.XAxis = &spec.Axis{}
+			c.YAxis = &spec.Axis{}
+		}
+		values, err := t.Floats(y[0])
+		if err != nil {
+			return nil, err
+		}
+		for index, label := range t.Strings(x) {
+			c.Values = append(c.Values, spec.Value{Label: label, Value: values[index]})
+		}
+	case TypeStacked:
+		c.Type = spec.TypeStackedBar
+		c.XAxis = &spec.Axis{}
+		c.YAxis = &spec.Axis{}
+		columns := make([][]float64, len(y))
+		for index, column := range y {
+			if columns[index], err = t.Floats(column); err != nil {
+				return nil, err
+			}
+		}
+		for row, name := range t.Strings(x) {
+			bar := spec.StackedBar{Name: name}
+			for index, column := range y {
+				bar.Values = append(bar.Values, spec.Value{Label: t.Columns[column], Value: columns[index][row]})
+			}
+			c.Stacks = append(c.Stacks, bar)
+		}
+	case TypeBubble:
+		if len(y) != 1 {
+			return nil, fmt.Errorf("a bubble chart takes exactly one y column, got %d", len(y))
+		}
+		if size < 0 {
+			return nil, fmt.Errorf("a bubble chart requires a size column")
+		}
+		c.Type = spec.TypeBubble
+		c.XAxis = &spec.Axis{Name: t.Columns[x]}
+		c.YAxis = &spec.Axis{Name: t.Columns[y[0]]}
+		xValues, err := t.Floats(x)
+		if err != nil {
+			return nil, err
+		}
+		yValues, err := t.Floats(y[0])
+		if err != nil {
+			return nil, err
+		}
+		sizes, err := t.Floats(size)
+		if err != nil {
+			return nil, err
+		}
+		for index := range t.Rows {
+			c.Bubbles = append(c.Bubbles, spec.Bubble{X: xValues[index], Y: yValues[index], Value: sizes[index]})
+		}
+	default:
+		return nil, fmt.Errorf("unknown chart type %q", o.Type)
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// resolveColumns returns the x, y and size column indexes, the size is -1 if not set.
+// The x column defaults to the first column and the y columns default to every other column.
+func resolveColumns(t *Table, o Options) (x int, y []int, size int, err error) {
+	size = -1
+	if o.Size != "" {
+		if size, err = t.ColumnIndex(o.Size); err != nil {
+			return
+		}
+	}
+	if o.X != "" {
+		if x, err = t.ColumnIndex(o.X); err != nil {
+			return
+		}
+	}
+	if len(o.Y) > 0 {
+		var column int
+		for _, name := range o.Y {
+			if column, err = t.ColumnIndex(name); err != nil {
+				return
+			}
+			y = append(y, column)
+		}
+		return
+	}
+	for column := range t.Columns {
+		if column != x && column != size {
+			y = append(y, column)
+		}
+	}
+	if len(y) == 0 {
+		err = fmt.Errorf("the input has no y columns")
+	}
+	return
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/spec"
+)
+
+const testCSV = "day,open,close,volume\n2020-01-01,1,2,10\n2020-01-02,2,3,20\n2020-01-03,3,2,15\n"
+
+func TestBuildSpecLineWithTimes(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader(testCSV), InputCSV, true)
+	assert.Nil(err)
+
+	c, err := BuildSpec(table, Options{Time: true, TimeFormat: "2006-01-02", Y: []string{"open", "close"}})
+	assert.Nil(err)
+	assert.Equal(spec.TypeLine, c.Type)
+	assert.Len(2, c.Series)
+	assert.Equal("close", c.Series[1].Name)
+	assert.Equal(spec.SeriesTime, c.Series[1].Type)
+	assert.Equal([]float64{2, 3, 2}, c.Series[1].YValues)
+	assert.Equal(spec.FormatterTime, c.XAxis.Formatter)
+}
+
+func TestBuildSpecStacked(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader(testCSV), InputCSV, true)
+	assert.Nil(err)
+
+	c, err := BuildSpec(table, Options{Type: TypeStacked, Y: []string{"1", "2"}})
+	assert.Nil(err)
+	assert.Len(3, c.Stacks)
+	assert.Equal("2020-01-02", c.Stacks[1].Name)
+	assert.Equal("close", c.Stacks[1].Values[1].Label)
+	assert.Equal(3.0, c.Stacks[1].Values[1].Value)
+}
+
+func TestBuildSpecErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader(testCSV), InputCSV, true)
+	assert.Nil(err)
+
+	_, err = BuildSpec(table, Options{Type: TypeBar})
+	assert.NotNil(err)
+	assert.Equal("a bar chart takes exactly one y column, got 3", err.Error())
+
+	_, err = BuildSpec(table, Options{Type: TypeBubble, X: "open", Y: []string{"close"}})
+	assert.NotNil(err)
+
+	_, err = BuildSpec(table, Options{Type: TypeLine})
+	assert.NotNil(err)
+	assert.True(strings.Contains(err.Error(), "is not a number"))
+
+	_, err = BuildSpec(table, Options{Type: "radar", Y: []string{"open"}})
+	assert.NotNil(err)
+}
+
+func TestRun(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout := bytes.NewBuffer([]byte{})
+	err := run([]string{"-type", "bubble", "-x", "open", "-y", "close", "-size", "volume", "-format", "svg"}, strings.NewReader(testCSV), stdout)
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(stdout.String(), "<svg"))
+
+	stdout.Reset()
+	err = run([]string{"-type", "pie", "-y", "volume"}, strings.NewReader(testCSV), stdout)
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(stdout.String(), "\x89PNG"))
+
+	err = run([]string{"-format", "gif"}, strings.NewReader(testCSV), stdout)
+	assert.NotNil(err)
+}
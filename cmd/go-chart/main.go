@@ -0,0 +1,134 @@
+// Command go-chart renders a chart from CSV, TSV or JSON lines data.
+//
+// Usage:
+//
+//	go-chart [flags] [file]
+//
+// The data is read from the file or from stdin if no file is given. The x column
+// defaults to the first column and the y columns default to every other column, e.g.
+//
+//	go-chart -type line -time -time-format 2006-01-02 -out prices.png prices.csv
+//	cat sales.tsv | go-chart -type bar -x region -y total -format svg > sales.svg
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	chart "github.com/daill/go-chart"
+)
+
+const (
+	// OutputPNG writes png images.
+	OutputPNG = "png"
+	// OutputSVG writes svg images.
+	OutputSVG = "svg"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "go-chart: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("go-chart", flag.ContinueOnError)
+
+	var o Options
+	var input, output, format, y string
+	var header bool
+	flags.StringVar(&o.Type, "type", TypeLine, "the chart type: line, bar, pie, stacked or bubble")
+	flags.StringVar(&o.Title, "title", "", "the chart title")
+	flags.IntVar(&o.Width, "width", 0, "the image width in pixels")
+	flags.IntVar(&o.Height, "height", 0, "the image height in pixels")
+	flags.StringVar(&o.X, "x", "", "the x (or label) column by name or zero based index, defaults to the first column")
+	flags.StringVar(&y, "y", "", "comma separated y (or value) columns, defaults to every other column")
+	flags.StringVar(&o.Size, "size", "", "the bubble size column of a bubble chart")
+	flags.BoolVar(&o.Time, "time", false, "parse the x column as times")
+	flags.StringVar(&o.TimeFormat, "time-format", "", "the time layout of the x column, defaults to RFC3339")
+	flags.StringVar(&o.Orientation, "orientation", "", "the orientation of bar charts: vertical or horizontal")
+	flags.StringVar(&input, "input", "", "the input format: csv, tsv or jsonl, defaults to the file extension or csv")
+	flags.BoolVar(&header, "header", true, "the first csv or tsv row holds the column names")
+	flags.StringVar(&output, "out", "", "the output file, defaults to stdout")
+	flags.StringVar(&format, "format", "", "the output format: png or svg, defaults to the output extension or png")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 1 {
+		return fmt.Errorf("expected at most one input file, got %d", flags.NArg())
+	}
+	if y != "" {
+		o.Y = strings.Split(y, ",")
+	}
+
+	r := stdin
+	if flags.NArg() == 1 {
+		f, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+		if input == "" {
+			input = inputFromPath(flags.Arg(0))
+		}
+	}
+	if input == "" {
+		input = InputCSV
+	}
+
+	if format == "" {
+		format = outputFromPath(output)
+	}
+	var rp chart.RendererProvider
+	switch format {
+	case OutputPNG:
+		rp = chart.PNG
+	case OutputSVG:
+		rp = chart.SVG
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+
+	t, err := ReadTable(r, input, header)
+	if err != nil {
+		return err
+	}
+	c, err := BuildSpec(t, o)
+	if err != nil {
+		return err
+	}
+
+	w := stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return c.Render(rp, w)
+}
+
+func inputFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".tsv", ".tab":
+		return InputTSV
+	case ".jsonl", ".ndjson":
+		return InputJSONLines
+	}
+	return InputCSV
+}
+
+func outputFromPath(path string) string {
+	if strings.ToLower(filepath.Ext(path)) == ".svg" {
+		return OutputSVG
+	}
+	return OutputPNG
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// InputCSV is comma separated values.
+	InputCSV = "csv"
+	// InputTSV is tab separated values.
+	InputTSV = "tsv"
+	// InputJSONLines is one json object per line.
+	InputJSONLines = "jsonl"
+)
+
+// Table is the tabular input data.
+type Table struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// ColumnIndex returns the index of a column given by name or by zero based index.
+func (t Table) ColumnIndex(column string) (int, error) {
+	for index, name := range t.Columns {
+		if name == column {
+			return index, nil
+		}
+	}
+	if index, err := strconv.Atoi(column); err == nil {
+		if index < 0 || index >= len(t.Columns) {
+			return 0, fmt.Errorf("column index %d out of range, the input has %d columns", index, len(t.Columns))
+		}
+		return index, nil
+	}
+	return 0, fmt.Errorf("unknown column %q, expected one of %s", column, strings.Join(t.Columns, ", "))
+}
+
+// Strings returns the values of a column.
+func (t Table) Strings(column int) []string {
+	values := make([]string, len(t.Rows))
+	for index, row := range t.Rows {
+		if column < len(row) {
+			values[index] = row[column]
+		}
+	}
+	return values
+}
+
+// Floats returns the values of a column parsed as floats.
+func (t Table) Floats(column int) ([]float64, error) {
+	values := make([]float64, len(t.Rows))
+	for index, value := range t.Strings(column) {
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d, column %q: %q is not a number", index+1, t.Columns[column], value)
+		}
+		values[index] = parsed
+	}
+	return values, nil
+}
+
+// ReadTable reads a table in a given input format.
+func ReadTable(r io.Reader, format string, header bool) (*Table, error) {
+	switch format {
+	case InputCSV:
+		return readDelimited(r, ',', header)
+	case InputTSV:
+		return readDelimited(r, '\t', header)
+	case InputJSONLines:
+		return readJSONLines(r)
+	}
+	return nil, fmt.Errorf("unknown input format %q", format)
+}
+
+func readDelimited(r io.Reader, comma rune, header bool) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the input is empty")
+	}
+
+	var t Table
+	if header {
+		t.Columns = records[0]
+		t.Rows = records[1:]
+	} else {
+		t.Rows = records
+		for index := range records[0] {
+			t.Columns = append(t.Columns, strconv.Itoa(index))
+		}
+	}
+	return &t, nil
+}
+
+// readJSONLines reads one object per line, the columns are the keys in the order they first appear.
+func readJSONLines(r io.Reader) (*Table, error) {
+	var t Table
+	columns := map[string]int{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var line int
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		keys, values, err := readJSONObject(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		row := make([]string, len(t.Columns))
+		for index, key := range keys {
+			column, ok := columns[key]
+			if !ok {
+				column = len(t.Columns)
+				columns[key] = column
+				t.Columns = append(t.Columns, key)
+				row = append(row, "")
+			}
+			row[column] = values[index]
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(t.Rows) == 0 {
+		return nil, fmt.Errorf("the input is empty")
+	}
+	return &t, nil
+}
+
+func readJSONObject(data []byte) (keys, values []string, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return
+	}
+	if delim, isDelim := token.(json.Delim); !isDelim || delim != '{' {
+		err = fmt.Errorf("expected a json object")
+		return
+	}
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return
+		}
+		var raw json.RawMessage
+		if err = decoder.Decode(&raw); err != nil {
+			return
+		}
+		var value string
+		if json.Unmarshal(raw, &value) != nil {
+			value = string(raw)
+		}
+		keys = append(keys, token.(string))
+		values = append(values, value)
+	}
+	return
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestReadTableCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader("x,y\n1, 2\n3,4\n"), InputCSV, true)
+	assert.Nil(err)
+	assert.Equal([]string{"x", "y"}, table.Columns)
+	assert.Len(2, table.Rows)
+
+	values, err := table.Floats(1)
+	assert.Nil(err)
+	assert.Equal([]float64{2, 4}, values)
+}
+
+func TestReadTableTSVWithoutHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader("a\t1\nb\t2\n"), InputTSV, false)
+	assert.Nil(err)
+	assert.Equal([]string{"0", "1"}, table.Columns)
+	assert.Equal([]string{"a", "b"}, table.Strings(0))
+
+	index, err := table.ColumnIndex("1")
+	assert.Nil(err)
+	assert.Equal(1, index)
+
+	_, err = table.ColumnIndex("5")
+	assert.NotNil(err)
+}
+
+func TestReadTableJSONLines(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader("{\"when\": \"2020-01-01\", \"value\": 1.5}\n\n{\"when\": \"2020-01-02\", \"value\": 2, \"extra\": true}\n"), InputJSONLines, true)
+	assert.Nil(err)
+	assert.Equal([]string{"when", "value", "extra"}, table.Columns)
+	assert.Equal([]string{"2020-01-01", "2020-01-02"}, table.Strings(0))
+	assert.Equal([]string{"", "true"}, table.Strings(2))
+
+	_, err = ReadTable(strings.NewReader("[1, 2]\n"), InputJSONLines, true)
+	assert.NotNil(err)
+	assert.True(strings.Contains(err.Error(), "line 1"))
+}
+
+func TestTableFloatsError(t *testing.T) {
+	assert := assert.New(t)
+
+	table, err := ReadTable(strings.NewReader("x,y\n1,2\n2,oops\n"), InputCSV, true)
+	assert.Nil(err)
+	_, err = table.Floats(1)
+	assert.NotNil(err)
+	assert.Equal("row 2, column \"y\": \"oops\" is not a number", err.Error())
+}
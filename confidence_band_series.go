@@ -0,0 +1,71 @@
+package chart
+
+import "fmt"
+
+// ConfidenceBandSeries draws a band between explicit lower and upper values,
+// i.e. the confidence interval around a series.
+type ConfidenceBandSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	XValues     []float64
+	LowerValues []float64
+	UpperValues []float64
+}
+
+// GetName returns the name of the band.
+func (cbs ConfidenceBandSeries) GetName() string {
+	return cbs.Name
+}
+
+// GetStyle returns the band style.
+func (cbs ConfidenceBandSeries) GetStyle() Style {
+	return cbs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cbs ConfidenceBandSeries) GetYAxis() YAxisType {
+	return cbs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (cbs ConfidenceBandSeries) Len() int {
+	return len(cbs.XValues)
+}
+
+// GetBoundedValues gets the x value and the upper and lower bound at a given index.
+func (cbs ConfidenceBandSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return cbs.XValues[index], cbs.UpperValues[index], cbs.LowerValues[index]
+}
+
+// GetBoundedLastValues gets the last x value and upper and lower bound.
+func (cbs ConfidenceBandSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	return cbs.GetBoundedValues(len(cbs.XValues) - 1)
+}
+
+// Render renders the series.
+func (cbs ConfidenceBandSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if cbs.Len() == 0 {
+		return
+	}
+	// the band is a translucent shade of the series color.
+	color := cbs.Style.GetStrokeColor(defaults.GetStrokeColor(DefaultAxisColor))
+	style := cbs.Style.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: color.WithAlpha(96),
+		FillColor:   color.WithAlpha(48),
+	})
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, style, cbs)
+}
+
+// Validate validates the series.
+func (cbs ConfidenceBandSeries) Validate() error {
+	if len(cbs.XValues) == 0 {
+		return fmt.Errorf("confidence band series must have xvalues set")
+	}
+	if len(cbs.LowerValues) != len(cbs.XValues) || len(cbs.UpperValues) != len(cbs.XValues) {
+		return fmt.Errorf("confidence band series must have as many lower and upper values as xvalues")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestConfidenceBandSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	cbs := ConfidenceBandSeries{
+		XValues:     []float64{1, 2, 3},
+		LowerValues: []float64{0, 1, 2},
+		UpperValues: []float64{2, 4, 6},
+	}
+	assert.Nil(cbs.Validate())
+
+	x, y1, y2 := cbs.GetBoundedLastValues()
+	assert.Equal(3.0, x)
+	assert.Equal(6.0, y1)
+	assert.Equal(2.0, y2)
+
+	c := Chart{Series: []Series{cbs, ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}}}}
+	_, yr, _ := c.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(6.0, yr.GetMax())
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+
+	cbs.UpperValues = cbs.UpperValues[:2]
+	assert.NotNil(cbs.Validate())
+}
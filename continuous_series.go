@@ -12,6 +12,9 @@ type ContinuousSeries struct {
 	XValueFormatter ValueFormatter
 	YValueFormatter ValueFormatter
 
+	// ErrorBars are the uncertainties of the values, drawn around each value.
+	ErrorBars ErrorBars
+
 	XValues []float64
 	YValues []float64
 }
@@ -65,6 +68,12 @@ func (cs ContinuousSeries) GetYAxis() YAxisType {
 func (cs ContinuousSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := cs.Style.InheritFrom(defaults)
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, cs)
+	Draw.ErrorBars(r, canvasBox, xrange, yrange, cs.ErrorBars.GetStyle(style), cs, cs.ErrorBars)
+}
+
+// GetErrorBars returns the error bars of the series.
+func (cs ContinuousSeries) GetErrorBars() ErrorBars {
+	return cs.ErrorBars
 }
 
 // Validate validates the series.
@@ -76,5 +85,9 @@ func (cs ContinuousSeries) Validate() error {
 	if len(cs.YValues) == 0 {
 		return fmt.Errorf("continuous series must have yvalues set")
 	}
-	return nil
+
+	if len(cs.XValues) != len(cs.YValues) {
+		return fmt.Errorf("continuous series has %d xvalues but %d yvalues", len(cs.XValues), len(cs.YValues))
+	}
+	return cs.ErrorBars.Validate(len(cs.XValues))
 }
@@ -47,6 +47,10 @@ const (
 	DefaultVerticalTickHeight = DefaultXAxisMargin >> 1
 	//DefaultHorizontalTickWidth is half the margin.
 	DefaultHorizontalTickWidth = DefaultYAxisMargin >> 1
+	// DefaultMinorVerticalTickHeight is half the height of a major tick.
+	DefaultMinorVerticalTickHeight = DefaultVerticalTickHeight >> 1
+	// DefaultMinorHorizontalTickWidth is half the width of a major tick.
+	DefaultMinorHorizontalTickWidth = DefaultHorizontalTickWidth >> 1
 
 	// DefaultTickCount is the default number of ticks to show
 	DefaultTickCount = 10
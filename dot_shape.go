@@ -0,0 +1,74 @@
+package chart
+
+import "math"
+
+// DotShape adds a marker of a given radius centered on x,y to the path of a renderer,
+// the caller fills and strokes it. Any func can be used to draw a custom marker.
+type DotShape func(r Renderer, radius float64, x, y int)
+
+var (
+	// DotShapeCircle is a circle, the default dot shape.
+	DotShapeCircle DotShape = func(r Renderer, radius float64, x, y int) {
+		r.Circle(radius, x, y)
+	}
+	// DotShapeSquare is a square.
+	DotShapeSquare = DotShapePath([2]float64{-1, -1}, [2]float64{1, -1}, [2]float64{1, 1}, [2]float64{-1, 1})
+	// DotShapeTriangle is a triangle pointing up.
+	DotShapeTriangle = DotShapePath([2]float64{0, -1}, [2]float64{0.866, 0.5}, [2]float64{-0.866, 0.5})
+	// DotShapeDiamond is a square standing on a corner.
+	DotShapeDiamond = DotShapePath([2]float64{0, -1}, [2]float64{1, 0}, [2]float64{0, 1}, [2]float64{-1, 0})
+	// DotShapePlus is an upright cross.
+	DotShapePlus = DotShapePath(
+		[2]float64{-0.3, -1}, [2]float64{0.3, -1}, [2]float64{0.3, -0.3}, [2]float64{1, -0.3},
+		[2]float64{1, 0.3}, [2]float64{0.3, 0.3}, [2]float64{0.3, 1}, [2]float64{-0.3, 1},
+		[2]float64{-0.3, 0.3}, [2]float64{-1, 0.3}, [2]float64{-1, -0.3}, [2]float64{-0.3, -0.3},
+	)
+	// DotShapeCross is a diagonal cross.
+	DotShapeCross = DotShapePath(rotateDotShapePoints(math.Pi/4,
+		[2]float64{-0.3, -1}, [2]float64{0.3, -1}, [2]float64{0.3, -0.3}, [2]float64{1, -0.3},
+		[2]float64{1, 0.3}, [2]float64{0.3, 0.3}, [2]float64{0.3, 1}, [2]float64{-0.3, 1},
+		[2]float64{-0.3, 0.3}, [2]float64{-1, 0.3}, [2]float64{-1, -0.3}, [2]float64{-0.3, -0.3},
+	)...)
+	// DotShapeStar is a five pointed star.
+	DotShapeStar = DotShapePath(starDotShapePoints(5, 0.4)...)
+)
+
+// DotShapePath returns a dot shape drawing a closed polygon through the given points,
+// which are relative to the center and scaled by the radius, i.e. {-1, -1} is the top left
+// corner of the square enclosing the dot.
+func DotShapePath(points ...[2]float64) DotShape {
+	return func(r Renderer, radius float64, x, y int) {
+		for index, p := range points {
+			px := x + int(math.Round(p[0]*radius))
+			py := y + int(math.Round(p[1]*radius))
+			if index == 0 {
+				r.MoveTo(px, py)
+			} else {
+				r.LineTo(px, py)
+			}
+		}
+		r.Close()
+	}
+}
+
+func rotateDotShapePoints(radians float64, points ...[2]float64) [][2]float64 {
+	sin, cos := math.Sin(radians), math.Cos(radians)
+	rotated := make([][2]float64, len(points))
+	for index, p := range points {
+		rotated[index] = [2]float64{p[0]*cos - p[1]*sin, p[0]*sin + p[1]*cos}
+	}
+	return rotated
+}
+
+func starDotShapePoints(tips int, innerRadius float64) [][2]float64 {
+	points := make([][2]float64, 2*tips)
+	for index := range points {
+		radius := 1.0
+		if index%2 == 1 {
+			radius = innerRadius
+		}
+		angle := float64(index)*math.Pi/float64(tips) - math.Pi/2
+		points[index] = [2]float64{radius * math.Cos(angle), radius * math.Sin(angle)}
+	}
+	return points
+}
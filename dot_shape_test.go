@@ -0,0 +1,69 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+)
+
+func TestDotShapePath(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+	DotShapeSquare(r, 2, 10, 20)
+	r.FillStroke()
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+	assert.True(strings.Contains(buffer.String(), "M 8 18\nL 12 18\nL 12 22\nL 8 22\nZ"), buffer.String())
+}
+
+func TestStyleGetDotShape(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(Style{}.GetDotShape())
+	assert.Nil(Style{}.InheritFrom(Style{}).DotShape)
+	assert.NotNil(Style{}.InheritFrom(Style{DotShape: DotShapeStar}).DotShape)
+	assert.True(Style{DotShapeProvider: func(_, _ Range, _ int, _, _ float64) DotShape { return nil }}.ShouldDrawDot())
+}
+
+func TestDrawLineSeriesDotShapes(t *testing.T) {
+	assert := assert.New(t)
+
+	var shapes []int
+	c := Chart{
+		Width:  200,
+		Height: 100,
+		Series: []Series{
+			ContinuousSeries{
+				Name: "test",
+				Style: Style{
+					Show:        true,
+					StrokeColor: drawing.ColorBlue,
+					DotColor:    drawing.ColorBlue,
+					DotWidth:    3,
+					DotShapeProvider: func(_, _ Range, index int, _, _ float64) DotShape {
+						shapes = append(shapes, index)
+						if index%2 == 0 {
+							return DotShapeTriangle
+						}
+						return nil
+					},
+				},
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 2, 3},
+			},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Equal([]int{0, 1, 2}, shapes)
+	// the odd dot falls back to a circle.
+	assert.True(strings.Contains(buffer.String(), "<circle"))
+}
@@ -22,25 +22,27 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	cb := canvasBox.Bottom
 	cl := canvasBox.Left
 
-	v0x, v0y := vs.GetValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y)
+	xs := make([]int, vs.Len())
+	ys := make([]int, vs.Len())
+	for i := 0; i < vs.Len(); i++ {
+		vx, vy := vs.GetValues(i)
+		xs[i] = cl + xrange.Translate(vx)
+		ys[i] = cb - yrange.Translate(vy)
+	}
+	x0, y0 := xs[0], ys[0]
+	xn := xs[len(xs)-1]
 
 	yv0 := yrange.Translate(0)
+	interpolation := style.GetInterpolation()
 
-	var vx, vy float64
-	var x, y int
+	defer d.clearElementInfo(r)
 
 	if style.ShouldDrawStroke() && style.ShouldDrawFill() {
+		d.seriesElementInfo(r, vs)
 		style.GetFillOptions().WriteDrawingOptionsToRenderer(r)
 		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
-		}
-		r.LineTo(x, util.Math.MinInt(cb, cb-yv0))
+		interpolate(r, xs, ys, interpolation)
+		r.LineTo(xn, util.Math.MinInt(cb, cb-yv0))
 		r.LineTo(x0, util.Math.MinInt(cb, cb-yv0))
 		r.LineTo(x0, y0)
 		r.Fill()
@@ -49,24 +51,25 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	if style.ShouldDrawStroke() {
 		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
 
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+		// the interactive stroke tags straight pieces per value, so curves are stroked as one piece.
+		if _, isInteractive := r.(InteractiveRenderer); isInteractive && interpolation == InterpolationLinear {
+			d.interactiveLineStroke(r, canvasBox, xrange, yrange, vs)
+		} else {
+			d.seriesElementInfo(r, vs)
+			r.MoveTo(x0, y0)
+			interpolate(r, xs, ys, interpolation)
+			r.Stroke()
 		}
-		r.Stroke()
 	}
 
 	if style.ShouldDrawDot() {
 		defaultDotWidth := style.GetDotWidth()
+		defaultDotShape := style.GetDotShape()
 
 		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
 		for i := 0; i < vs.Len(); i++ {
-			vx, vy = vs.GetValues(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
+			vx, vy := vs.GetValues(i)
+			x, y := xs[i], ys[i]
 
 			dotWidth := defaultDotWidth
 			if style.DotWidthProvider != nil {
@@ -80,12 +83,79 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 				r.SetStrokeColor(dotColor)
 			}
 
-			r.Circle(dotWidth, x, y)
+			dotShape := defaultDotShape
+			if style.DotShapeProvider != nil {
+				dotShape = style.GetDotShape(style.DotShapeProvider(xrange, yrange, i, vx, vy))
+			}
+
+			d.valueElementInfo(r, vs, vx, vy)
+			dotShape(r, dotWidth, x, y)
 			r.FillStroke()
 		}
 	}
 }
 
+// interactiveLineStroke strokes a line series as one piece per value, from half way to the
+// previous value to half way to the next, so each piece can be tagged with its value.
+func (d draw) interactiveLineStroke(r Renderer, canvasBox Box, xrange, yrange Range, vs ValuesProvider) {
+	xs := make([]int, vs.Len())
+	ys := make([]int, vs.Len())
+	for i := 0; i < vs.Len(); i++ {
+		vx, vy := vs.GetValues(i)
+		xs[i] = canvasBox.Left + xrange.Translate(vx)
+		ys[i] = canvasBox.Bottom - yrange.Translate(vy)
+	}
+
+	for i := 0; i < vs.Len(); i++ {
+		if i > 0 {
+			r.MoveTo((xs[i-1]+xs[i])>>1, (ys[i-1]+ys[i])>>1)
+		} else {
+			r.MoveTo(xs[i], ys[i])
+		}
+		r.LineTo(xs[i], ys[i])
+		if i < vs.Len()-1 {
+			r.LineTo((xs[i]+xs[i+1])>>1, (ys[i]+ys[i+1])>>1)
+		}
+
+		vx, vy := vs.GetValues(i)
+		d.valueElementInfo(r, vs, vx, vy)
+		r.Stroke()
+	}
+}
+
+// seriesElementInfo tags the elements drawn by an interactive renderer with the name of a series.
+func (d draw) seriesElementInfo(r Renderer, series interface{}) {
+	if typed, isTyped := r.(InteractiveRenderer); isTyped {
+		typed.SetElementInfo(ElementInfo{Series: d.getSeriesName(series)})
+	}
+}
+
+// valueElementInfo tags the elements drawn by an interactive renderer with a value of a series,
+// formatted with the series value formatters.
+func (d draw) valueElementInfo(r Renderer, series interface{}, vx, vy float64) {
+	if typed, isTyped := r.(InteractiveRenderer); isTyped {
+		xf, yf := FloatValueFormatter, FloatValueFormatter
+		if vfp, isVFP := series.(ValueFormatterProvider); isVFP {
+			xf, yf = vfp.GetValueFormatters()
+		}
+		typed.SetElementInfo(ElementInfo{Series: d.getSeriesName(series), X: xf(vx), Y: yf(vy)})
+	}
+}
+
+// clearElementInfo clears the element info of an interactive renderer.
+func (d draw) clearElementInfo(r Renderer) {
+	if typed, isTyped := r.(InteractiveRenderer); isTyped {
+		typed.ClearElementInfo()
+	}
+}
+
+func (d draw) getSeriesName(series interface{}) string {
+	if typed, isTyped := series.(NameProvider); isTyped {
+		return typed.GetName()
+	}
+	return ""
+}
+
 // BoundedSeries draws a series that implements BoundedValuesProvider.
 func (d draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, bbs BoundedValuesProvider, drawOffsetIndexes ...int) {
 	drawOffsetIndex := 0
@@ -108,6 +178,9 @@ func (d draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, sty
 	y2values := make([]float64, bbs.Len())
 	y2values[0] = v0y2
 
+	d.seriesElementInfo(r, bbs)
+	defer d.clearElementInfo(r)
+
 	style.GetFillAndStrokeOptions().WriteToRenderer(r)
 	r.MoveTo(x0, y0)
 	for i := 1; i < bbs.Len(); i++ {
@@ -136,6 +209,54 @@ func (d draw) BoundedSeries(r Renderer, canvasBox Box, xrange, yrange Range, sty
 	r.FillStroke()
 }
 
+// ErrorBars draws the error bars of the values of a series as whiskers with caps.
+func (d draw) ErrorBars(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider, eb ErrorBars) {
+	if eb.IsZero() {
+		return
+	}
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+	cw2 := eb.GetCapWidth() >> 1
+
+	defer d.clearElementInfo(r)
+
+	style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
+	for i := 0; i < vs.Len(); i++ {
+		vx, vy := vs.GetValues(i)
+		x := cl + xrange.Translate(vx)
+		y := cb - yrange.Translate(vy)
+
+		d.valueElementInfo(r, vs, vx, vy)
+		if lower, upper := eb.GetYErrors(i); lower != 0 || upper != 0 {
+			y1 := cb - yrange.Translate(vy-lower)
+			y2 := cb - yrange.Translate(vy+upper)
+			r.MoveTo(x, y1)
+			r.LineTo(x, y2)
+			if cw2 > 0 {
+				r.MoveTo(x-cw2, y1)
+				r.LineTo(x+cw2, y1)
+				r.MoveTo(x-cw2, y2)
+				r.LineTo(x+cw2, y2)
+			}
+			r.Stroke()
+		}
+		if lower, upper := eb.GetXErrors(i); lower != 0 || upper != 0 {
+			x1 := cl + xrange.Translate(vx-lower)
+			x2 := cl + xrange.Translate(vx+upper)
+			r.MoveTo(x1, y)
+			r.LineTo(x2, y)
+			if cw2 > 0 {
+				r.MoveTo(x1, y-cw2)
+				r.LineTo(x1, y+cw2)
+				r.MoveTo(x2, y-cw2)
+				r.LineTo(x2, y+cw2)
+			}
+			r.Stroke()
+		}
+	}
+}
+
 // HistogramSeries draws a value provider as boxes from 0.
 func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider, barWidths ...int) {
 	if vs.Len() == 0 {
@@ -168,6 +289,52 @@ func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, s
 	}
 }
 
+// Candlestick draws a single candle centered on x; a wick from the high to the low and a body
+// from the open to the close. Coordinates are absolute.
+func (d draw) Candlestick(r Renderer, x, width, yOpen, yHigh, yLow, yClose int, style Style) {
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(x, yHigh)
+	r.LineTo(x, yLow)
+	r.Stroke()
+
+	w2 := width >> 1
+	if yOpen == yClose {
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x-w2, yOpen)
+		r.LineTo(x+w2, yOpen)
+		r.Stroke()
+		r.ResetStyle()
+		return
+	}
+
+	d.Box(r, Box{
+		Top:    util.Math.MinInt(yOpen, yClose),
+		Left:   x - w2,
+		Right:  x + w2,
+		Bottom: util.Math.MaxInt(yOpen, yClose),
+	}, style)
+}
+
+// OHLCBar draws a single open-high-low-close bar centered on x; a vertical line from the high to the low,
+// a tick to the left at the open and a tick to the right at the close. Coordinates are absolute.
+func (d draw) OHLCBar(r Renderer, x, width, yOpen, yHigh, yLow, yClose int, style Style) {
+	style.GetStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	w2 := width >> 1
+	r.MoveTo(x, yHigh)
+	r.LineTo(x, yLow)
+	r.Stroke()
+
+	r.MoveTo(x-w2, yOpen)
+	r.LineTo(x, yOpen)
+	r.Stroke()
+
+	r.MoveTo(x, yClose)
+	r.LineTo(x+w2, yClose)
+	r.Stroke()
+}
+
 // MeasureAnnotation measures how big an annotation would be.
 func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) Box {
 	style.WriteToRenderer(r)
@@ -241,6 +408,13 @@ func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, lab
 	r.Text(label, textX, textY)
 }
 
+// Dot draws a single marker with the dot shape, width and color of a style.
+func (d draw) Dot(r Renderer, x, y int, style Style) {
+	style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
+	style.GetDotShape()(r, style.GetDotWidth(), x, y)
+	r.FillStroke()
+}
+
 // Bubble with given style
 func (d draw) Circle(r Renderer, c Bubble, s Style) {
 	s.GetFillAndStrokeOptions().WriteToRenderer(r)
@@ -307,7 +481,7 @@ func (d draw) TextWithin(r Renderer, text string, box Box, style Style) {
 	case TextVerticalAlignBottom, TextVerticalAlignBaseline: // i have to build better baseline handling into measure text
 		y = y - linesBox.Height()
 	case TextVerticalAlignMiddle, TextVerticalAlignMiddleBaseline:
-		y = (y - linesBox.Height()) >> 1
+		y = y + ((box.Height() - linesBox.Height()) >> 1)
 	}
 
 	var tx, ty int
@@ -0,0 +1,48 @@
+package chart
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestDrawTextWithinMiddle(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := SVG(300, 300)
+	assert.Nil(err)
+
+	style := Style{
+		Font:                f,
+		FontSize:            DefaultFontSize,
+		FontColor:           ColorBlack,
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignMiddle,
+	}
+	style.GetTextOptions().WriteToRenderer(r)
+	textBox := r.MeasureText("middle")
+
+	// the box doesn't start at the top, so the text has to be offset by it.
+	Draw.TextWithin(r, "middle", Box{Top: 100, Left: 0, Right: 300, Bottom: 200}, style)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+
+	text := regexp.MustCompile(`<text x="(\d+)" y="(\d+)"`).FindStringSubmatch(buffer.String())
+	assert.Len(3, text)
+	y, err := strconv.Atoi(text[2])
+	assert.Nil(err)
+
+	// the text sits on its baseline, so it spans y-height to y.
+	above := (y - textBox.Height()) - 100
+	below := 200 - y
+	assert.True(above >= 0)
+	assert.True(below >= 0)
+	assert.True(above-below <= 1 && below-above <= 1)
+}
@@ -0,0 +1,256 @@
+package drawing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseColor returns a color from a css color string: a hex code with or without a leading `#`
+// of 3, 4, 6 or 8 digits, an `rgb()` or `rgba()` function, or a named color like `steelblue`.
+func ParseColor(value string) (Color, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	if c, ok := ColorFromName(s); ok {
+		return c, nil
+	}
+	if strings.HasPrefix(s, "rgb") {
+		if c, ok := parseRGBFunction(s); ok {
+			return c, nil
+		}
+		return Color{}, fmt.Errorf("invalid rgb color %q", value)
+	}
+	if c, ok := parseHexColor(strings.TrimPrefix(s, "#")); ok {
+		return c, nil
+	}
+	return Color{}, fmt.Errorf("invalid color %q", value)
+}
+
+// ColorFromName returns a css named color, case insensitive, and if the name is known.
+func ColorFromName(name string) (Color, bool) {
+	name = strings.ToLower(name)
+	if name == "transparent" {
+		return ColorTransparent, true
+	}
+	rgb, ok := namedColors[name]
+	if !ok {
+		return Color{}, false
+	}
+	return Color{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 255}, true
+}
+
+// parseHexColor parses a hex code of 3, 4, 6 or 8 digits without the leading `#`.
+func parseHexColor(hex string) (Color, bool) {
+	if _, err := strconv.ParseUint(hex, 16, 32); err != nil {
+		return Color{}, false
+	}
+	switch len(hex) {
+	case 3, 6:
+		return ColorFromHex(hex), true
+	case 4:
+		return ColorFromHex(hex[:3]).WithAlpha(parseHex(hex[3:]) * 0x11), true
+	case 8:
+		return ColorFromHex(hex[:6]).WithAlpha(parseHex(hex[6:])), true
+	}
+	return Color{}, false
+}
+
+// parseRGBFunction parses `rgb()` and `rgba()` with comma or space separated channels, the
+// channels are numbers from 0 to 255 or percentages and the alpha is a number from 0 to 1 or a
+// percentage, i.e. `rgb(70, 130, 180)`, `rgba(70,130,180,0.5)` or `rgb(27% 51% 71% / 50%)`.
+func parseRGBFunction(s string) (Color, bool) {
+	var args string
+	switch {
+	case strings.HasPrefix(s, "rgba(") && strings.HasSuffix(s, ")"):
+		args = s[len("rgba(") : len(s)-1]
+	case strings.HasPrefix(s, "rgb(") && strings.HasSuffix(s, ")"):
+		args = s[len("rgb(") : len(s)-1]
+	default:
+		return Color{}, false
+	}
+
+	parts := strings.FieldsFunc(args, func(r rune) bool {
+		return r == ',' || r == '/' || r == ' ' || r == '\t'
+	})
+	if len(parts) != 3 && len(parts) != 4 {
+		return Color{}, false
+	}
+
+	c := Color{A: 255}
+	channels := []*uint8{&c.R, &c.G, &c.B, &c.A}
+	for index, part := range parts {
+		scale := 255.0
+		if index == 3 {
+			scale = 1.0
+		}
+		v, ok := parseColorComponent(part, scale)
+		if !ok {
+			return Color{}, false
+		}
+		*channels[index] = channelFromFloat(v)
+	}
+	return c, true
+}
+
+// parseColorComponent parses a percentage or a number on the given scale, and returns it from 0 to 1.
+func parseColorComponent(part string, scale float64) (float64, bool) {
+	if strings.HasSuffix(part, "%") {
+		scale, part = 100, strings.TrimSuffix(part, "%")
+	}
+	v, err := strconv.ParseFloat(part, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v / scale, true
+}
+
+// namedColors are the css named colors as 0xRRGGBB.
+var namedColors = map[string]uint32{
+	"aliceblue":            0xf0f8ff,
+	"antiquewhite":         0xfaebd7,
+	"aqua":                 0x00ffff,
+	"aquamarine":           0x7fffd4,
+	"azure":                0xf0ffff,
+	"beige":                0xf5f5dc,
+	"bisque":               0xffe4c4,
+	"black":                0x000000,
+	"blanchedalmond":       0xffebcd,
+	"blue":                 0x0000ff,
+	"blueviolet":           0x8a2be2,
+	"brown":                0xa52a2a,
+	"burlywood":            0xdeb887,
+	"cadetblue":            0x5f9ea0,
+	"chartreuse":           0x7fff00,
+	"chocolate":            0xd2691e,
+	"coral":                0xff7f50,
+	"cornflowerblue":       0x6495ed,
+	"cornsilk":             0xfff8dc,
+	"crimson":              0xdc143c,
+	"cyan":                 0x00ffff,
+	"darkblue":             0x00008b,
+	"darkcyan":             0x008b8b,
+	"darkgoldenrod":        0xb8860b,
+	"darkgray":             0xa9a9a9,
+	"darkgreen":            0x006400,
+	"darkgrey":             0xa9a9a9,
+	"darkkhaki":            0xbdb76b,
+	"darkmagenta":          0x8b008b,
+	"darkolivegreen":       0x556b2f,
+	"darkorange":           0xff8c00,
+	"darkorchid":           0x9932cc,
+	"darkred":              0x8b0000,
+	"darksalmon":           0xe9967a,
+	"darkseagreen":         0x8fbc8f,
+	"darkslateblue":        0x483d8b,
+	"darkslategray":        0x2f4f4f,
+	"darkslategrey":        0x2f4f4f,
+	"darkturquoise":        0x00ced1,
+	"darkviolet":           0x9400d3,
+	"deeppink":             0xff1493,
+	"deepskyblue":          0x00bfff,
+	"dimgray":              0x696969,
+	"dimgrey":              0x696969,
+	"dodgerblue":           0x1e90ff,
+	"firebrick":            0xb22222,
+	"floralwhite":          0xfffaf0,
+	"forestgreen":          0x228b22,
+	"fuchsia":              0xff00ff,
+	"gainsboro":            0xdcdcdc,
+	"ghostwhite":           0xf8f8ff,
+	"gold":                 0xffd700,
+	"goldenrod":            0xdaa520,
+	"gray":                 0x808080,
+	"green":                0x008000,
+	"greenyellow":          0xadff2f,
+	"grey":                 0x808080,
+	"honeydew":             0xf0fff0,
+	"hotpink":              0xff69b4,
+	"indianred":            0xcd5c5c,
+	"indigo":               0x4b0082,
+	"ivory":                0xfffff0,
+	"khaki":                0xf0e68c,
+	"lavender":             0xe6e6fa,
+	"lavenderblush":        0xfff0f5,
+	"lawngreen":            0x7cfc00,
+	"lemonchiffon":         0xfffacd,
+	"lightblue":            0xadd8e6,
+	"lightcoral":           0xf08080,
+	"lightcyan":            0xe0ffff,
+	"lightgoldenrodyellow": 0xfafad2,
+	"lightgray":            0xd3d3d3,
+	"lightgreen":           0x90ee90,
+	"lightgrey":            0xd3d3d3,
+	"lightpink":            0xffb6c1,
+	"lightsalmon":          0xffa07a,
+	"lightseagreen":        0x20b2aa,
+	"lightskyblue":         0x87cefa,
+	"lightslategray":       0x778899,
+	"lightslategrey":       0x778899,
+	"lightsteelblue":       0xb0c4de,
+	"lightyellow":          0xffffe0,
+	"lime":                 0x00ff00,
+	"limegreen":            0x32cd32,
+	"linen":                0xfaf0e6,
+	"magenta":              0xff00ff,
+	"maroon":               0x800000,
+	"mediumaquamarine":     0x66cdaa,
+	"mediumblue":           0x0000cd,
+	"mediumorchid":         0xba55d3,
+	"mediumpurple":         0x9370db,
+	"mediumseagreen":       0x3cb371,
+	"mediumslateblue":      0x7b68ee,
+	"mediumspringgreen":    0x00fa9a,
+	"mediumturquoise":      0x48d1cc,
+	"mediumvioletred":      0xc71585,
+	"midnightblue":         0x191970,
+	"mintcream":            0xf5fffa,
+	"mistyrose":            0xffe4e1,
+	"moccasin":             0xffe4b5,
+	"navajowhite":          0xffdead,
+	"navy":                 0x000080,
+	"oldlace":              0xfdf5e6,
+	"olive":                0x808000,
+	"olivedrab":            0x6b8e23,
+	"orange":               0xffa500,
+	"orangered":            0xff4500,
+	"orchid":               0xda70d6,
+	"palegoldenrod":        0xeee8aa,
+	"palegreen":            0x98fb98,
+	"paleturquoise":        0xafeeee,
+	"palevioletred":        0xdb7093,
+	"papayawhip":           0xffefd5,
+	"peachpuff":            0xffdab9,
+	"peru":                 0xcd853f,
+	"pink":                 0xffc0cb,
+	"plum":                 0xdda0dd,
+	"powderblue":           0xb0e0e6,
+	"purple":               0x800080,
+	"rebeccapurple":        0x663399,
+	"red":                  0xff0000,
+	"rosybrown":            0xbc8f8f,
+	"royalblue":            0x4169e1,
+	"saddlebrown":          0x8b4513,
+	"salmon":               0xfa8072,
+	"sandybrown":           0xf4a460,
+	"seagreen":             0x2e8b57,
+	"seashell":             0xfff5ee,
+	"sienna":               0xa0522d,
+	"silver":               0xc0c0c0,
+	"skyblue":              0x87ceeb,
+	"slateblue":            0x6a5acd,
+	"slategray":            0x708090,
+	"slategrey":            0x708090,
+	"snow":                 0xfffafa,
+	"springgreen":          0x00ff7f,
+	"steelblue":            0x4682b4,
+	"tan":                  0xd2b48c,
+	"teal":                 0x008080,
+	"thistle":              0xd8bfd8,
+	"tomato":               0xff6347,
+	"turquoise":            0x40e0d0,
+	"violet":               0xee82ee,
+	"wheat":                0xf5deb3,
+	"white":                0xffffff,
+	"whitesmoke":           0xf5f5f5,
+	"yellow":               0xffff00,
+	"yellowgreen":          0x9acd32,
+}
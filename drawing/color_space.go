@@ -0,0 +1,222 @@
+package drawing
+
+import "math"
+
+// ColorFromHSL returns an opaque color from a hue in degrees, and a saturation and lightness from 0 to 1.
+func ColorFromHSL(h, s, l float64) Color {
+	h, s, l = normalizeHue(h), clamp01(s), clamp01(l)
+	chroma := (1 - math.Abs(2*l-1)) * s
+	return colorFromHueChroma(h, chroma, l-chroma/2)
+}
+
+// ColorFromHSV returns an opaque color from a hue in degrees, and a saturation and value from 0 to 1.
+func ColorFromHSV(h, s, v float64) Color {
+	h, s, v = normalizeHue(h), clamp01(s), clamp01(v)
+	chroma := v * s
+	return colorFromHueChroma(h, chroma, v-chroma)
+}
+
+// ColorFromLab returns an opaque color from CIE L*a*b* coordinates with a D65 white point,
+// colors outside of the sRGB gamut are clipped.
+func ColorFromLab(l, a, b float64) Color {
+	fy := (l + 16) / 116
+	fx := fy + a/500
+	fz := fy - b/200
+
+	x := labWhiteX * labFInverse(fx)
+	y := labWhiteY * labFInverse(fy)
+	z := labWhiteZ * labFInverse(fz)
+
+	return Color{
+		R: channelFromLinear(3.2404542*x - 1.5371385*y - 0.4985314*z),
+		G: channelFromLinear(-0.9692660*x + 1.8760108*y + 0.0415560*z),
+		B: channelFromLinear(0.0556434*x - 0.2040259*y + 1.0572252*z),
+		A: 255,
+	}
+}
+
+// HSL returns the hue of the color in degrees, and its saturation and lightness from 0 to 1.
+func (c Color) HSL() (h, s, l float64) {
+	r, g, b := c.floats()
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+	if max == min {
+		return 0, 0, l
+	}
+	chroma := max - min
+	s = chroma / (1 - math.Abs(2*l-1))
+	return hue(r, g, b, max, chroma), s, l
+}
+
+// HSV returns the hue of the color in degrees, and its saturation and value from 0 to 1.
+func (c Color) HSV() (h, s, v float64) {
+	r, g, b := c.floats()
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	if max == min {
+		return 0, 0, max
+	}
+	chroma := max - min
+	return hue(r, g, b, max, chroma), chroma / max, max
+}
+
+// Lab returns the CIE L*a*b* coordinates of the color with a D65 white point,
+// the lightness goes from 0 to 100.
+func (c Color) Lab() (l, a, b float64) {
+	r, g, bl := c.floats()
+	r, g, bl = linearFromSRGB(r), linearFromSRGB(g), linearFromSRGB(bl)
+
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*bl) / labWhiteX
+	y := (0.2126729*r + 0.7151522*g + 0.0721750*bl) / labWhiteY
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*bl) / labWhiteZ
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// Lighten returns a copy of the color with its HSL lightness raised by an amount from 0 to 1.
+func (c Color) Lighten(amount float64) Color {
+	h, s, l := c.HSL()
+	return ColorFromHSL(h, s, l+amount).WithAlpha(c.A)
+}
+
+// Darken returns a copy of the color with its HSL lightness lowered by an amount from 0 to 1.
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// Saturate returns a copy of the color with its HSL saturation raised by an amount from 0 to 1.
+func (c Color) Saturate(amount float64) Color {
+	h, s, l := c.HSL()
+	return ColorFromHSL(h, s+amount, l).WithAlpha(c.A)
+}
+
+// Desaturate returns a copy of the color with its HSL saturation lowered by an amount from 0 to 1.
+func (c Color) Desaturate(amount float64) Color {
+	return c.Saturate(-amount)
+}
+
+// Interpolate returns the color t of the way from the color to another, from 0 to 1.
+// It blends in Lab space, so the steps look evenly spaced, and blends the alpha linearly.
+func (c Color) Interpolate(other Color, t float64) Color {
+	t = clamp01(t)
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := other.Lab()
+	blended := ColorFromLab(l1+(l2-l1)*t, a1+(a2-a1)*t, b1+(b2-b1)*t)
+	return blended.WithAlpha(uint8(math.Round(float64(c.A) + (float64(other.A)-float64(c.A))*t)))
+}
+
+// Luminance returns the relative luminance of the color from 0 for black to 1 for white,
+// as defined by WCAG. It ignores the alpha.
+func (c Color) Luminance() float64 {
+	r, g, b := c.floats()
+	return 0.2126*linearFromSRGB(r) + 0.7152*linearFromSRGB(g) + 0.0722*linearFromSRGB(b)
+}
+
+// ContrastRatio returns the WCAG contrast ratio of the color and another, from 1 to 21.
+func (c Color) ContrastRatio(other Color) float64 {
+	l1, l2 := c.Luminance(), other.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// ContrastingTextColor returns black or white, whichever has the higher contrast on the color,
+// i.e. for labels on a filled region.
+func (c Color) ContrastingTextColor() Color {
+	if c.ContrastRatio(ColorBlack) >= c.ContrastRatio(ColorWhite) {
+		return ColorBlack
+	}
+	return ColorWhite
+}
+
+// the D65 white point.
+const (
+	labWhiteX = 0.95047
+	labWhiteY = 1.0
+	labWhiteZ = 1.08883
+)
+
+func labF(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16) / 116
+}
+
+func labFInverse(t float64) float64 {
+	if cubed := t * t * t; cubed > 216.0/24389.0 {
+		return cubed
+	}
+	return (116*t - 16) * 27.0 / 24389.0
+}
+
+func linearFromSRGB(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func channelFromLinear(v float64) uint8 {
+	if v <= 0.0031308 {
+		return channelFromFloat(12.92 * v)
+	}
+	return channelFromFloat(1.055*math.Pow(v, 1/2.4) - 0.055)
+}
+
+// channelFromFloat returns a byte from a value from 0 to 1, rounded and clamped.
+func channelFromFloat(v float64) uint8 {
+	return uint8(math.Round(clamp01(v) * 255))
+}
+
+func (c Color) floats() (r, g, b float64) {
+	return float64(c.R) / 255, float64(c.G) / 255, float64(c.B) / 255
+}
+
+// hue returns the hue in degrees of the color with the given largest channel and chroma.
+func hue(r, g, b, max, chroma float64) float64 {
+	var h float64
+	switch max {
+	case r:
+		h = math.Mod((g-b)/chroma, 6)
+	case g:
+		h = (b-r)/chroma + 2
+	default:
+		h = (r-g)/chroma + 4
+	}
+	return normalizeHue(h * 60)
+}
+
+// colorFromHueChroma returns the color of a hue with the given chroma, offset by m in every channel.
+func colorFromHueChroma(h, chroma, m float64) Color {
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g = chroma, x
+	case h < 120:
+		r, g = x, chroma
+	case h < 180:
+		g, b = chroma, x
+	case h < 240:
+		g, b = x, chroma
+	case h < 300:
+		r, b = x, chroma
+	default:
+		r, b = chroma, x
+	}
+	return Color{R: channelFromFloat(r + m), G: channelFromFloat(g + m), B: channelFromFloat(b + m), A: 255}
+}
+
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -51,3 +51,137 @@ func TestColorFromAlphaMixedRGBA(t *testing.T) {
 	white := ColorFromAlphaMixedRGBA(color.White.RGBA())
 	assert.True(white.Equals(ColorWhite), white.String())
 }
+
+func TestColorHSL(t *testing.T) {
+	assert := assert.New(t)
+
+	h, s, l := Color{R: 70, G: 130, B: 180, A: 255}.HSL()
+	assert.InDelta(207.27, h, 0.01)
+	assert.InDelta(0.44, s, 0.01)
+	assert.InDelta(0.49, l, 0.01)
+
+	h, s, l = ColorWhite.HSL()
+	assert.Zero(h)
+	assert.Zero(s)
+	assert.Equal(1.0, l)
+
+	for _, c := range []Color{ColorRed, ColorGreen, ColorBlue, {R: 70, G: 130, B: 180, A: 255}, {R: 200, G: 10, B: 90, A: 255}} {
+		assert.Equal(c, ColorFromHSL(c.HSL()))
+		assert.Equal(c, ColorFromHSV(c.HSV()))
+	}
+	assert.Equal(ColorBlue, ColorFromHSL(-120, 1, 0.5))
+}
+
+func TestColorHSV(t *testing.T) {
+	assert := assert.New(t)
+
+	h, s, v := Color{R: 255, G: 128, B: 0, A: 255}.HSV()
+	assert.InDelta(30.12, h, 0.01)
+	assert.Equal(1.0, s)
+	assert.Equal(1.0, v)
+
+	_, s, v = ColorBlack.HSV()
+	assert.Zero(s)
+	assert.Zero(v)
+}
+
+func TestColorLab(t *testing.T) {
+	assert := assert.New(t)
+
+	l, a, b := ColorWhite.Lab()
+	assert.InDelta(100.0, l, 0.01)
+	assert.InDelta(0.0, a, 0.01)
+	assert.InDelta(0.0, b, 0.01)
+
+	l, a, b = ColorRed.Lab()
+	assert.InDelta(53.24, l, 0.01)
+	assert.InDelta(80.09, a, 0.01)
+	assert.InDelta(67.20, b, 0.01)
+
+	for _, c := range []Color{ColorBlack, ColorRed, {R: 70, G: 130, B: 180, A: 255}} {
+		assert.Equal(c, ColorFromLab(c.Lab()))
+	}
+}
+
+func TestColorLightenDarken(t *testing.T) {
+	assert := assert.New(t)
+
+	red := ColorRed.WithAlpha(128)
+	assert.Equal(Color{R: 255, G: 102, B: 102, A: 128}, red.Lighten(0.2))
+	assert.Equal(Color{R: 153, G: 0, B: 0, A: 128}, red.Darken(0.2))
+	assert.Equal(ColorWhite, ColorRed.Lighten(1))
+	assert.Equal(ColorBlack, ColorRed.Darken(1))
+
+	gray := Color{R: 128, G: 128, B: 128, A: 255}
+	assert.Equal(gray, ColorRed.Desaturate(1))
+	_, s, _ := Color{R: 150, G: 100, B: 100, A: 255}.Saturate(0.3).HSL()
+	assert.InDelta(0.5, s, 0.01)
+}
+
+func TestColorInterpolate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(ColorBlack, ColorBlack.Interpolate(ColorWhite, 0))
+	assert.Equal(ColorWhite, ColorBlack.Interpolate(ColorWhite, 1))
+	assert.Equal(ColorWhite, ColorBlack.Interpolate(ColorWhite, 2))
+
+	// the perceptual middle of black and white is lighter than the rgb middle.
+	middle := ColorBlack.Interpolate(ColorWhite, 0.5)
+	assert.Equal(middle.R, middle.G)
+	assert.True(middle.R > 118 && middle.R < 120)
+
+	assert.Equal(uint8(128), ColorRed.WithAlpha(0).Interpolate(ColorBlue, 0.5).A)
+}
+
+func TestColorContrast(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.InDelta(21.0, ColorBlack.ContrastRatio(ColorWhite), 0.001)
+	assert.InDelta(21.0, ColorWhite.ContrastRatio(ColorBlack), 0.001)
+	assert.Equal(1.0, ColorRed.ContrastRatio(ColorRed))
+	assert.InDelta(4.0, ColorRed.ContrastRatio(ColorWhite), 0.01)
+
+	assert.Equal(ColorBlack, ColorWhite.ContrastingTextColor())
+	assert.Equal(ColorBlack, ColorFromHex("ffd700").ContrastingTextColor())
+	assert.Equal(ColorWhite, ColorFromHex("000080").ContrastingTextColor())
+}
+
+func TestParseColor(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := map[string]Color{
+		"#fff":                      ColorWhite,
+		"0000ff":                    ColorBlue,
+		"#FF000080":                 ColorRed.WithAlpha(128),
+		"#f008":                     ColorRed.WithAlpha(0x88),
+		"SteelBlue":                 {R: 70, G: 130, B: 180, A: 255},
+		" rebeccapurple ":           {R: 0x66, G: 0x33, B: 0x99, A: 255},
+		"transparent":               ColorTransparent,
+		"rgb(70, 130, 180)":         {R: 70, G: 130, B: 180, A: 255},
+		"rgba(255,0,0,0.5)":         ColorRed.WithAlpha(128),
+		"rgb(100% 0% 0% / 50%)":     ColorRed.WithAlpha(128),
+		"RGBA(0, 0, 255, 1)":        ColorBlue,
+		"rgb(300, -10, 0)":          ColorRed,
+		"rgba(0, 255, 0, 25%)":      ColorGreen.WithAlpha(64),
+		"rgb(0.5, 254.6, 0)":        {R: 1, G: 255, B: 0, A: 255},
+		"rgba(0, 0, 0 / 0)":         ColorTransparent,
+		"rgb( 255 , 255 , 255 ) ":   ColorWhite,
+		"rgba(255, 255, 255, 100%)": ColorWhite,
+	}
+	for value, expected := range valid {
+		c, err := ParseColor(value)
+		assert.Nil(err, value)
+		assert.Equal(expected, c, value)
+	}
+
+	for _, value := range []string{"", "#ff000", "#12345g", "blurple", "rgb(1, 2)", "rgb(1, 2, 3, 4, 5)", "rgb(a, b, c)", "rgb(1, 2, 3"} {
+		_, err := ParseColor(value)
+		assert.NotNil(err, value)
+	}
+
+	c, ok := ColorFromName("Tomato")
+	assert.True(ok)
+	assert.Equal(Color{R: 255, G: 99, B: 71, A: 255}, c)
+	_, ok = ColorFromName("#fff")
+	assert.False(ok)
+}
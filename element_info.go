@@ -0,0 +1,50 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ElementInfo describes the series and data point an element drawn by an `InteractiveRenderer` represents.
+type ElementInfo struct {
+	// Series is the name of the series.
+	Series string
+	// X and Y are the formatted values of the data point, empty if the element represents the whole series.
+	X, Y string
+}
+
+// HasValue returns if the info describes a single data point.
+func (ei ElementInfo) HasValue() bool {
+	return len(ei.X) > 0 || len(ei.Y) > 0
+}
+
+// Title returns the tooltip text for the element.
+func (ei ElementInfo) Title() string {
+	if !ei.HasValue() {
+		return ei.Series
+	}
+	if len(ei.Series) == 0 {
+		return fmt.Sprintf("%s, %s", ei.X, ei.Y)
+	}
+	return fmt.Sprintf("%s: %s, %s", ei.Series, ei.X, ei.Y)
+}
+
+// ClassName returns the css class names for the element; every element gets `series`, and
+// named series get an additional class from their lower cased name, e.g. `series-cpu-usage`.
+func (ei ElementInfo) ClassName() string {
+	buffer := bytes.NewBuffer([]byte{})
+	for _, c := range strings.ToLower(ei.Series) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			buffer.WriteRune(c)
+		} else if buffer.Len() > 0 && !strings.HasSuffix(buffer.String(), "-") {
+			buffer.WriteRune('-')
+		}
+	}
+	name := strings.TrimSuffix(buffer.String(), "-")
+	if len(name) == 0 {
+		return "series"
+	}
+	return "series series-" + name
+}
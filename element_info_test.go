@@ -0,0 +1,25 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestElementInfoTitle(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("", ElementInfo{}.Title())
+	assert.Equal("Test", ElementInfo{Series: "Test"}.Title())
+	assert.Equal("1.00, 2.00", ElementInfo{X: "1.00", Y: "2.00"}.Title())
+	assert.Equal("Test: 1.00, 2.00", ElementInfo{Series: "Test", X: "1.00", Y: "2.00"}.Title())
+}
+
+func TestElementInfoClassName(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("series", ElementInfo{}.ClassName())
+	assert.Equal("series", ElementInfo{Series: " % "}.ClassName())
+	assert.Equal("series series-cpu-usage", ElementInfo{Series: "CPU Usage"}.ClassName())
+	assert.Equal("series series-p99-latency-ms", ElementInfo{Series: "  p99 latency (ms) "}.ClassName())
+}
@@ -0,0 +1,106 @@
+package chart
+
+import "fmt"
+
+const (
+	// DefaultErrorBarCapWidth is the default width of the caps at the ends of error bars.
+	DefaultErrorBarCapWidth = 6
+)
+
+// ErrorBarsProvider is a series with error bars, the chart ranges are extended to include them.
+type ErrorBarsProvider interface {
+	ValuesProvider
+	GetErrorBars() ErrorBars
+}
+
+// ErrorBars are the uncertainties of the values of a series, drawn as whiskers with caps around each value.
+// Errors are distances from the value and are given either symmetric, or asymmetric as lower and upper
+// errors which take precedence. For time series the x errors are nanoseconds, i.e. `float64(time.Minute)`.
+type ErrorBars struct {
+	// Style is the style of the bars, it inherits the stroke of the series, or its dot color
+	// and a default width if the series has no line (i.e. a scatter series).
+	Style Style
+	// CapWidth is the width of the caps in pixels, `Disabled` draws no caps.
+	CapWidth int
+
+	YErrors      []float64
+	YErrorsLower []float64
+	YErrorsUpper []float64
+
+	XErrors      []float64
+	XErrorsLower []float64
+	XErrorsUpper []float64
+}
+
+// IsZero returns if the error bars have no errors set.
+func (eb ErrorBars) IsZero() bool {
+	return len(eb.YErrors) == 0 && len(eb.YErrorsLower) == 0 && len(eb.YErrorsUpper) == 0 &&
+		len(eb.XErrors) == 0 && len(eb.XErrorsLower) == 0 && len(eb.XErrorsUpper) == 0
+}
+
+// GetCapWidth returns the cap width or a default.
+func (eb ErrorBars) GetCapWidth() int {
+	if eb.CapWidth == 0 {
+		return DefaultErrorBarCapWidth
+	}
+	if eb.CapWidth < 0 {
+		return 0
+	}
+	return eb.CapWidth
+}
+
+// GetStyle returns the style of the bars inheriting from the style of their series.
+func (eb ErrorBars) GetStyle(series Style) Style {
+	if series.StrokeWidth > 0 {
+		return eb.Style.InheritFrom(Style{StrokeColor: series.StrokeColor, StrokeWidth: series.StrokeWidth})
+	}
+	color := series.StrokeColor
+	if !series.DotColor.IsZero() {
+		color = series.DotColor
+	}
+	return eb.Style.InheritFrom(Style{StrokeColor: color, StrokeWidth: DefaultSeriesLineWidth})
+}
+
+// GetYErrors returns the distance below and above the value at the given index.
+func (eb ErrorBars) GetYErrors(index int) (lower, upper float64) {
+	return errorsAt(index, eb.YErrors, eb.YErrorsLower, eb.YErrorsUpper)
+}
+
+// GetXErrors returns the distance left and right of the value at the given index.
+func (eb ErrorBars) GetXErrors(index int) (lower, upper float64) {
+	return errorsAt(index, eb.XErrors, eb.XErrorsLower, eb.XErrorsUpper)
+}
+
+// Validate validates the errors are set for `length` values.
+func (eb ErrorBars) Validate(length int) error {
+	errors := []struct {
+		name   string
+		values []float64
+	}{
+		{"y errors", eb.YErrors},
+		{"lower y errors", eb.YErrorsLower},
+		{"upper y errors", eb.YErrorsUpper},
+		{"x errors", eb.XErrors},
+		{"lower x errors", eb.XErrorsLower},
+		{"upper x errors", eb.XErrorsUpper},
+	}
+	for _, e := range errors {
+		if len(e.values) > 0 && len(e.values) != length {
+			return fmt.Errorf("error bars have %d %s for %d values", len(e.values), e.name, length)
+		}
+	}
+	return nil
+}
+
+func errorsAt(index int, symmetric, lowers, uppers []float64) (lower, upper float64) {
+	if index < len(symmetric) {
+		lower, upper = symmetric[index], symmetric[index]
+	}
+	if index < len(lowers) {
+		lower = lowers[index]
+	}
+	if index < len(uppers) {
+		upper = uppers[index]
+	}
+	return
+}
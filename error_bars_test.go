@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+	"github.com/daill/go-chart/util"
+)
+
+func TestErrorBarsGetErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	eb := ErrorBars{
+		YErrors:      []float64{1, 2},
+		YErrorsUpper: []float64{5, 6},
+		XErrorsLower: []float64{0.5, 0.25},
+	}
+	assert.False(eb.IsZero())
+	assert.True(ErrorBars{}.IsZero())
+
+	lower, upper := eb.GetYErrors(1)
+	assert.Equal(2.0, lower)
+	assert.Equal(6.0, upper)
+
+	lower, upper = eb.GetXErrors(0)
+	assert.Equal(0.5, lower)
+	assert.Equal(0.0, upper)
+
+	assert.Equal(DefaultErrorBarCapWidth, eb.GetCapWidth())
+	assert.Zero(ErrorBars{CapWidth: Disabled}.GetCapWidth())
+
+	assert.Nil(eb.Validate(2))
+	assert.NotNil(eb.Validate(3))
+}
+
+func TestChartErrorBarsRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{10, 20, 30},
+				ErrorBars: ErrorBars{
+					YErrorsLower: []float64{5, 0, 0},
+					YErrorsUpper: []float64{0, 0, 8},
+					XErrors:      []float64{0.5, 0, 0},
+				},
+			},
+		},
+	}
+
+	xr, yr, _ := c.getRanges()
+	assert.Equal(0.5, xr.GetMin())
+	assert.Equal(3.0, xr.GetMax())
+	assert.Equal(5.0, yr.GetMin())
+	assert.Equal(38.0, yr.GetMax())
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+
+	c.Series = []Series{ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}, ErrorBars: ErrorBars{YErrors: []float64{1}}}}
+	assert.NotNil(c.validateSeries())
+}
+
+func TestTimeSeriesErrorBars(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ts := TimeSeries{
+		XValues:   []time.Time{start, start.Add(time.Hour)},
+		YValues:   []float64{1, 2},
+		ErrorBars: ErrorBars{XErrors: []float64{float64(time.Minute), float64(time.Minute)}, YErrors: []float64{0.5, 0.5}},
+	}
+
+	c := Chart{Series: []Series{ts}}
+	xr, _, _ := c.getRanges()
+	assert.Equal(util.Time.ToFloat64(start.Add(-time.Minute)), xr.GetMin())
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+}
+
+func TestScatterSeriesErrorBars(t *testing.T) {
+	assert := assert.New(t)
+
+	eb := ErrorBars{YErrors: []float64{1, 1}}
+	style := eb.GetStyle(Style{StrokeColor: drawing.ColorBlue, StrokeWidth: Disabled, DotColor: drawing.ColorRed, DotWidth: 3})
+	assert.Equal(DefaultSeriesLineWidth, style.StrokeWidth)
+	assert.Equal(drawing.ColorRed, style.StrokeColor)
+
+	style = eb.GetStyle(Style{StrokeColor: drawing.ColorBlue, StrokeWidth: 2})
+	assert.Equal(2.0, style.StrokeWidth)
+	assert.Equal(drawing.ColorBlue, style.StrokeColor)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Style:     Style{Show: true, StrokeWidth: Disabled, DotWidth: 3},
+				XValues:   []float64{1, 2},
+				YValues:   []float64{1, 2},
+				ErrorBars: eb,
+			},
+		},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.False(strings.Contains(buffer.String(), "stroke-width:-1"))
+	assert.True(strings.Contains(buffer.String(), "stroke-width:1"))
+}
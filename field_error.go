@@ -0,0 +1,34 @@
+package chart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError is a validation error of a single field of a chart, e.g. `Series[1]`.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error implements error.
+func (fe FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", fe.Field, fe.Err)
+}
+
+// Unwrap returns the underlying error.
+func (fe FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// FieldErrors are the validation errors of a chart.
+type FieldErrors []FieldError
+
+// Error implements error.
+func (fe FieldErrors) Error() string {
+	messages := make([]string, len(fe))
+	for index, err := range fe {
+		messages[index] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,35 @@
+package chart
+
+import "io"
+
+var (
+	_ Graph = Chart{}
+	_ Graph = BarChart{}
+	_ Graph = BoxPlotChart{}
+	_ Graph = BubbleChart{}
+	_ Graph = GroupedBarChart{}
+	_ Graph = HeatmapChart{}
+	_ Graph = Layout{}
+	_ Graph = PieChart{}
+	_ Graph = StackedBarChart{}
+	_ Graph = StackedValueBarChart{}
+)
+
+// Graph is the interface shared by every chart type.
+type Graph interface {
+	// GetDPI returns the dpi of the chart, or the first default if it isn't set.
+	GetDPI(defaults ...float64) float64
+
+	// GetWidth returns the width of the chart.
+	GetWidth() int
+
+	// GetHeight returns the height of the chart.
+	GetHeight() int
+
+	// Render renders the chart into a new renderer and saves it to the writer.
+	Render(rp RendererProvider, w io.Writer) error
+
+	// RenderInto renders the chart into a box of an existing renderer,
+	// sized to the box and using the renderer's dpi unless the chart sets its own.
+	RenderInto(r Renderer, box Box) error
+}
@@ -1,5 +1,7 @@
 package chart
 
+import "math"
+
 // GridLineProvider is a type that provides grid lines.
 type GridLineProvider interface {
 	GetGridLines(ticks []Tick, isVertical bool, majorStyle, minorStyle Style) []GridLine
@@ -70,3 +72,32 @@ func GenerateGridLines(ticks []Tick, majorStyle, minorStyle Style) []GridLine {
 	}
 	return gl
 }
+
+// majorGridLines returns the major lines of a set of grid lines.
+func majorGridLines(gridLines []GridLine) []GridLine {
+	var major []GridLine
+	for _, gl := range gridLines {
+		if gl.Major() {
+			major = append(major, gl)
+		}
+	}
+	return major
+}
+
+// GenerateGridLinesWithMinorTicks generates a major grid line for every tick and a minor grid line
+// for every minor tick value, leaving out lines on or outside the range bounds.
+func GenerateGridLinesWithMinorTicks(ra Range, ticks []Tick, minorTicks []float64, majorStyle, minorStyle Style) []GridLine {
+	var gl []GridLine
+	min, max := math.Min(ra.GetMin(), ra.GetMax()), math.Max(ra.GetMin(), ra.GetMax())
+	for _, t := range ticks {
+		if t.Value > min && t.Value < max {
+			gl = append(gl, GridLine{Style: majorStyle, Value: t.Value})
+		}
+	}
+	for _, value := range minorTicks {
+		if value > min && value < max {
+			gl = append(gl, GridLine{Style: minorStyle, IsMinor: true, Value: value})
+		}
+	}
+	return gl
+}
@@ -22,3 +22,17 @@ func TestGenerateGridLines(t *testing.T) {
 	assert.Equal(2.0, gl[0].Value)
 	assert.Equal(3.0, gl[1].Value)
 }
+
+func TestGenerateGridLinesWithMinorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &ContinuousRange{Min: 1, Max: 3}
+	ticks := []Tick{{Value: 1}, {Value: 2}, {Value: 3}}
+	gl := GenerateGridLinesWithMinorTicks(ra, ticks, GenerateMinorTicks(ra, ticks, 2), Style{}, Style{})
+	assert.Len(3, gl)
+	assert.Equal(2.0, gl[0].Value)
+	assert.True(gl[0].Major())
+	assert.Equal(1.5, gl[1].Value)
+	assert.True(gl[1].Minor())
+	assert.Equal(2.5, gl[2].Value)
+}
@@ -0,0 +1,588 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultGroupedBarWidth is the default pixel width of a single bar within a group.
+	DefaultGroupedBarWidth = 20
+	// DefaultGroupedBarSpacing is the default pixel spacing between the bars within a group.
+	DefaultGroupedBarSpacing = 2
+	// DefaultGroupSpacing is the default pixel spacing between groups.
+	DefaultGroupSpacing = 40
+)
+
+// BarGroup is a set of bars drawn side-by-side within a GroupedBarChart.
+// The value at a given index is colored the same in every group, and its title is used in the legend.
+type BarGroup struct {
+	Name   string
+	Values []Value
+}
+
+// GroupedBarChart is a chart that draws clustered bars, one cluster per group, on a shared range.
+type GroupedBarChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	XAxis Style
+	YAxis YAxis
+
+	// BarWidth is the width of a single bar within a group.
+	BarWidth int
+	// BarSpacing is the inner spacing between the bars within a group.
+	BarSpacing int
+	// GroupSpacing is the outer spacing between groups.
+	GroupSpacing int
+
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Groups   []BarGroup
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (gbc GroupedBarChart) GetDPI(defaults ...float64) float64 {
+	if gbc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return gbc.DPI
+}
+
+// GetFont returns the text font.
+func (gbc GroupedBarChart) GetFont() *truetype.Font {
+	if gbc.Font == nil {
+		if theme := gbc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
+		return gbc.defaultFont
+	}
+	return gbc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (gbc GroupedBarChart) GetWidth() int {
+	if gbc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return gbc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (gbc GroupedBarChart) GetHeight() int {
+	if gbc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return gbc.Height
+}
+
+// GetBarWidth returns the width of a single bar.
+func (gbc GroupedBarChart) GetBarWidth() int {
+	if gbc.BarWidth == 0 {
+		return DefaultGroupedBarWidth
+	}
+	return gbc.BarWidth
+}
+
+// GetBarSpacing returns the spacing between the bars within a group.
+func (gbc GroupedBarChart) GetBarSpacing() int {
+	if gbc.BarSpacing == 0 {
+		return DefaultGroupedBarSpacing
+	}
+	return gbc.BarSpacing
+}
+
+// GetGroupSpacing returns the spacing between groups.
+func (gbc GroupedBarChart) GetGroupSpacing() int {
+	if gbc.GroupSpacing == 0 {
+		return DefaultGroupSpacing
+	}
+	return gbc.GroupSpacing
+}
+
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (gbc GroupedBarChart) Validate() error {
+	if len(gbc.Groups) == 0 || gbc.getBarsPerGroup() == 0 {
+		return FieldError{Field: "Groups", Err: errors.New("please provide at least one group with at least one bar")}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (gbc GroupedBarChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := gbc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(gbc.GetWidth(), gbc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if gbc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		gbc.defaultFont = defaultFont
+	}
+	r.SetDPI(gbc.GetDPI())
+
+	gbc.drawBackground(r)
+
+	var canvasBox Box
+	var yt []Tick
+	var yr Range
+	var yf ValueFormatter
+
+	legend := gbc.getLegend()
+	canvasBox = legend.adjustCanvasBox(r, gbc.getDefaultCanvasBox())
+	yr = gbc.getRanges()
+	yr = gbc.setRangeDomains(canvasBox, yr)
+	yf = gbc.getValueFormatters()
+
+	if gbc.hasAxes() {
+		yt = gbc.getAxesTicks(r, yr, yf)
+		canvasBox = gbc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
+		yr = gbc.setRangeDomains(canvasBox, yr)
+	}
+	gbc.drawCanvas(r, canvasBox)
+	gbc.drawBars(r, canvasBox, yr)
+	gbc.drawXAxis(r, canvasBox)
+	gbc.drawYAxis(r, canvasBox, yr, yt)
+	legend.draw(r, gbc.getDefaultCanvasBox(), canvasBox)
+
+	gbc.drawTitle(r)
+	for _, a := range gbc.Elements {
+		a(r, canvasBox, gbc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// RenderInto renders the chart into a box of an existing renderer.
+func (gbc GroupedBarChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	gbc.Width, gbc.Height, gbc.DPI = box.Width(), box.Height(), gbc.GetDPI(r.GetDPI())
+	return gbc.Render(BoxRendererProvider(r, box), nil)
+}
+
+func (gbc GroupedBarChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  gbc.GetWidth(),
+		Bottom: gbc.GetHeight(),
+	}, gbc.getBackgroundStyle())
+}
+
+func (gbc GroupedBarChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, gbc.getCanvasStyle())
+}
+
+// getRanges returns the shared value range of every bar in every group.
+// The range always includes zero, as bars are drawn from zero.
+func (gbc GroupedBarChart) getRanges() Range {
+	var yrange Range
+	if gbc.YAxis.Range != nil && !gbc.YAxis.Range.IsZero() {
+		yrange = gbc.YAxis.Range
+	} else {
+		yrange = &ContinuousRange{}
+	}
+
+	if !yrange.IsZero() {
+		return yrange
+	}
+
+	if len(gbc.YAxis.Ticks) > 0 {
+		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
+		for _, t := range gbc.YAxis.Ticks {
+			tickMin = math.Min(tickMin, t.Value)
+			tickMax = math.Max(tickMax, t.Value)
+		}
+		yrange.SetMin(tickMin)
+		yrange.SetMax(tickMax)
+		return yrange
+	}
+
+	var min, max float64
+	for _, g := range gbc.Groups {
+		for _, v := range g.Values {
+			min = math.Min(v.Value, min)
+			max = math.Max(v.Value, max)
+		}
+	}
+
+	if min == max {
+		max = min + 1
+	}
+
+	yrange.SetMin(min)
+	yrange.SetMax(max)
+
+	return yrange
+}
+
+func (gbc GroupedBarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
+	barWidth, barSpacing, groupSpacing := gbc.calculateScaledWidths(canvasBox)
+	groupWidth := gbc.calculateGroupWidth(barWidth, barSpacing)
+	gs2 := groupSpacing >> 1
+
+	// bars grow up (or down) from zero, clamped to the canvas if zero is out of range.
+	zero := canvasBox.Bottom - yr.Translate(0)
+	zero = util.Math.MaxInt(canvasBox.Top, util.Math.MinInt(canvasBox.Bottom, zero))
+
+	xoffset := canvasBox.Left
+	for _, g := range gbc.Groups {
+		bxl := xoffset + gs2
+		for index, bv := range g.Values {
+			by := canvasBox.Bottom - yr.Translate(bv.Value)
+
+			barBox := Box{
+				Top:    util.Math.MinInt(by, zero),
+				Left:   bxl,
+				Right:  bxl + barWidth,
+				Bottom: util.Math.MaxInt(by, zero),
+			}
+			Draw.Box(r, barBox, bv.Style.InheritFrom(gbc.styleDefaultsBar(index)))
+
+			bxl += barWidth + barSpacing
+		}
+		xoffset += groupWidth + groupSpacing
+	}
+}
+
+func (gbc GroupedBarChart) drawXAxis(r Renderer, canvasBox Box) {
+	if gbc.XAxis.Show {
+		axisStyle := gbc.XAxis.InheritFrom(gbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		barWidth, barSpacing, groupSpacing := gbc.calculateScaledWidths(canvasBox)
+		groupWidth := gbc.calculateGroupWidth(barWidth, barSpacing)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom+DefaultVerticalTickHeight)
+		r.Stroke()
+
+		cursor := canvasBox.Left
+		for index, g := range gbc.Groups {
+			groupLabelBox := Box{
+				Top:    canvasBox.Bottom + DefaultXAxisMargin,
+				Left:   cursor,
+				Right:  cursor + groupWidth + groupSpacing,
+				Bottom: gbc.GetHeight(),
+			}
+
+			if len(g.Name) > 0 {
+				Draw.TextWithin(r, g.Name, groupLabelBox, axisStyle)
+			}
+
+			axisStyle.WriteToRenderer(r)
+			if index < len(gbc.Groups)-1 {
+				r.MoveTo(groupLabelBox.Right, canvasBox.Bottom)
+				r.LineTo(groupLabelBox.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
+				r.Stroke()
+			}
+			cursor += groupWidth + groupSpacing
+		}
+	}
+}
+
+func (gbc GroupedBarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
+	if gbc.YAxis.Style.Show {
+		axisStyle := gbc.YAxis.Style.InheritFrom(gbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Right, canvasBox.Top)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Right, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, canvasBox.Bottom)
+		r.Stroke()
+
+		var ty int
+		var tb Box
+		for _, t := range ticks {
+			ty = canvasBox.Bottom - yr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(canvasBox.Right, ty)
+			r.LineTo(canvasBox.Right+DefaultHorizontalTickWidth, ty)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb = r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, canvasBox.Right+DefaultYAxisMargin+5, ty+(tb.Height()>>1), axisStyle)
+		}
+	}
+}
+
+func (gbc GroupedBarChart) drawTitle(r Renderer) {
+	if len(gbc.Title) > 0 && gbc.TitleStyle.Show {
+		titleStyle := gbc.TitleStyle.InheritFrom(gbc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(gbc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(gbc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(gbc.getTitleFontSize())
+		r.SetFontSize(titleFontSize)
+
+		textBox := r.MeasureText(gbc.Title)
+
+		textWidth := textBox.Width()
+		textHeight := textBox.Height()
+
+		titleX := (gbc.GetWidth() >> 1) - (textWidth >> 1)
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+
+		r.Text(gbc.Title, titleX, titleY)
+	}
+}
+
+func (gbc GroupedBarChart) hasAxes() bool {
+	return gbc.YAxis.Style.Show
+}
+
+func (gbc GroupedBarChart) setRangeDomains(canvasBox Box, yr Range) Range {
+	yr.SetDomain(canvasBox.Height())
+	return yr
+}
+
+func (gbc GroupedBarChart) getDefaultCanvasBox() Box {
+	return gbc.box()
+}
+
+func (gbc GroupedBarChart) getValueFormatters() ValueFormatter {
+	if gbc.YAxis.ValueFormatter != nil {
+		return gbc.YAxis.ValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+func (gbc GroupedBarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks []Tick) {
+	if gbc.YAxis.Style.Show {
+		yticks = gbc.YAxis.GetTicks(r, yr, gbc.styleDefaultsAxes(), yf)
+	}
+	return
+}
+
+// getBarsPerGroup returns the number of bars in the largest group.
+func (gbc GroupedBarChart) getBarsPerGroup() (count int) {
+	for _, g := range gbc.Groups {
+		count = util.Math.MaxInt(count, len(g.Values))
+	}
+	return
+}
+
+func (gbc GroupedBarChart) calculateGroupWidth(barWidth, barSpacing int) int {
+	bars := gbc.getBarsPerGroup()
+	if bars == 0 {
+		return 0
+	}
+	return bars*barWidth + (bars-1)*barSpacing
+}
+
+func (gbc GroupedBarChart) calculateTotalWidth(barWidth, barSpacing, groupSpacing int) int {
+	return len(gbc.Groups) * (gbc.calculateGroupWidth(barWidth, barSpacing) + groupSpacing)
+}
+
+// calculateScaledWidths returns the bar width, bar spacing and group spacing,
+// scaled down proportionally if the groups would not fit within the canvas.
+func (gbc GroupedBarChart) calculateScaledWidths(canvasBox Box) (barWidth, barSpacing, groupSpacing int) {
+	barWidth, barSpacing, groupSpacing = gbc.GetBarWidth(), gbc.GetBarSpacing(), gbc.GetGroupSpacing()
+
+	total := gbc.calculateTotalWidth(barWidth, barSpacing, groupSpacing)
+	if total <= canvasBox.Width() || total == 0 {
+		return
+	}
+
+	scale := float64(canvasBox.Width()) / float64(total)
+	barWidth = util.Math.MaxInt(1, int(math.Floor(float64(barWidth)*scale)))
+	barSpacing = int(math.Floor(float64(barSpacing) * scale))
+	groupSpacing = int(math.Floor(float64(groupSpacing) * scale))
+	return
+}
+
+func (gbc GroupedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range, yticks []Tick) Box {
+	axesOuterBox := canvasBox.Clone()
+
+	barWidth, barSpacing, groupSpacing := gbc.calculateScaledWidths(canvasBox)
+	groupWidth := gbc.calculateGroupWidth(barWidth, barSpacing)
+
+	if gbc.XAxis.Show {
+		xaxisHeight := DefaultVerticalTickHeight
+
+		axisStyle := gbc.XAxis.InheritFrom(gbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		for _, g := range gbc.Groups {
+			if len(g.Name) > 0 {
+				lines := Text.WrapFit(r, g.Name, groupWidth+groupSpacing, axisStyle)
+				linesBox := Text.MeasureLines(r, lines, axisStyle)
+
+				xaxisHeight = util.Math.MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+			}
+		}
+
+		xbox := Box{
+			Top:    canvasBox.Top,
+			Left:   canvasBox.Left,
+			Right:  canvasBox.Left + gbc.calculateTotalWidth(barWidth, barSpacing, groupSpacing),
+			Bottom: canvasBox.Bottom + xaxisHeight,
+		}
+
+		axesOuterBox = axesOuterBox.Grow(xbox)
+	}
+
+	if gbc.YAxis.Style.Show {
+		axesBounds := gbc.YAxis.Measure(r, canvasBox, yrange, gbc.styleDefaultsAxes(), yticks)
+		axesOuterBox = axesOuterBox.Grow(axesBounds)
+	}
+
+	return canvasBox.OuterConstrain(gbc.getLegend().adjustCanvasBox(r, gbc.box()), axesOuterBox)
+}
+
+// box returns the chart bounds as a box.
+func (gbc GroupedBarChart) box() Box {
+	padding := gbc.GetTheme().BackgroundStyle.Padding
+	dpr := gbc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := gbc.Background.Padding.GetBottom(padding.GetBottom(50))
+
+	return Box{
+		Top:    gbc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   gbc.Background.Padding.GetLeft(padding.GetLeft(20)),
+		Right:  gbc.GetWidth() - dpr,
+		Bottom: gbc.GetHeight() - dpb,
+	}
+}
+
+func (gbc GroupedBarChart) getCanvasStyle() Style {
+	return gbc.Canvas.InheritFrom(gbc.styleDefaultsCanvas())
+}
+
+func (gbc GroupedBarChart) styleDefaultsCanvas() Style {
+	return gbc.GetTheme().CanvasStyle.InheritFrom(Style{
+		FillColor:   gbc.GetColorPalette().CanvasColor(),
+		StrokeColor: gbc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultCanvasStrokeWidth,
+	})
+}
+
+func (gbc GroupedBarChart) getBackgroundStyle() Style {
+	return gbc.Background.InheritFrom(gbc.styleDefaultsBackground())
+}
+
+func (gbc GroupedBarChart) styleDefaultsBackground() Style {
+	return gbc.GetTheme().BackgroundStyle.InheritFrom(Style{
+		FillColor:   gbc.GetColorPalette().BackgroundColor(),
+		StrokeColor: gbc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+// styleDefaultsBar returns the default style for the bar at a given index within each group.
+func (gbc GroupedBarChart) styleDefaultsBar(index int) Style {
+	return gbc.GetTheme().SeriesStyle.InheritFrom(Style{
+		StrokeColor: gbc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: 1.0,
+		FillColor:   gbc.GetColorPalette().GetSeriesColor(index),
+	})
+}
+
+func (gbc GroupedBarChart) getTitleFontSize() float64 {
+	effectiveDimension := util.Math.MinInt(gbc.GetWidth(), gbc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+func (gbc GroupedBarChart) styleDefaultsAxes() Style {
+	return gbc.GetTheme().inheritAxisStyle(Style{
+		StrokeColor:         gbc.GetColorPalette().AxisStrokeColor(),
+		Font:                gbc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           gbc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for the title of every value of the first group,
+// the values at the same index of the other groups share its color.
+func (gbc GroupedBarChart) GetLegendEntries() []LegendEntry {
+	if len(gbc.Groups) == 0 {
+		return nil
+	}
+	var entries []LegendEntry
+	for index, bv := range gbc.Groups[0].Values {
+		if bv.Style.IsZero() || bv.Style.Show {
+			entries = append(entries, LegendEntry{
+				Label:  bv.Title,
+				Style:  bv.Style.InheritFrom(gbc.styleDefaultsBar(index)),
+				Marker: LegendMarkerBox,
+			})
+		}
+	}
+	return entries
+}
+
+func (gbc GroupedBarChart) getLegend() chartLegend {
+	return newChartLegend(gbc.Legend, gbc, styleDefaultsLegend(gbc.GetTheme(), gbc.GetFont(), gbc.GetColorPalette()))
+}
+
+func (gbc GroupedBarChart) styleDefaultsElements() Style {
+	return Style{
+		Font: gbc.GetFont(),
+	}
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (gbc GroupedBarChart) GetColorPalette() ColorPalette {
+	if gbc.ColorPalette != nil {
+		return gbc.ColorPalette
+	}
+	if theme := gbc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
+	return AlternateColorPalette
+}
+
+// GetTheme returns the theme of the chart or the default theme.
+func (gbc GroupedBarChart) GetTheme() Theme {
+	if gbc.Theme != nil {
+		return *gbc.Theme
+	}
+	return DefaultTheme
+}
@@ -0,0 +1,78 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestGroupedBarChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	gbc := GroupedBarChart{
+		Title:      "Test Title",
+		TitleStyle: StyleShow(),
+		XAxis:      StyleShow(),
+		YAxis: YAxis{
+			Style: StyleShow(),
+		},
+		Groups: []BarGroup{
+			{Name: "Monday", Values: []Value{{Value: 1.0, Title: "api"}, {Value: 2.0, Title: "web"}, {Value: 3.0, Title: "worker"}}},
+			{Name: "Tuesday", Values: []Value{{Value: 2.0}, {Value: -1.0}, {Value: 4.0}}},
+		},
+	}
+	gbc.Elements = []Renderable{
+		LegendGroupedBarChart(&gbc),
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(gbc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+
+	assert.NotNil(GroupedBarChart{}.Render(PNG, buf))
+	assert.NotNil(GroupedBarChart{Groups: []BarGroup{{Name: "Empty"}}}.Render(PNG, buf))
+}
+
+func TestGroupedBarChartGetRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	gbc := GroupedBarChart{
+		Groups: []BarGroup{
+			{Name: "Monday", Values: []Value{{Value: 1.0, Title: "api"}, {Value: 2.0, Title: "web"}, {Value: 3.0, Title: "worker"}}},
+			{Name: "Tuesday", Values: []Value{{Value: 2.0}, {Value: -1.0}, {Value: 4.0}}},
+		},
+	}
+	yr := gbc.getRanges()
+	assert.Equal(-1.0, yr.GetMin())
+	assert.Equal(4.0, yr.GetMax())
+
+	gbc.Groups = []BarGroup{{Values: []Value{{Value: 2.0}, {Value: 3.0}}}}
+	yr = gbc.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(3.0, yr.GetMax())
+}
+
+func TestGroupedBarChartCalculateScaledWidths(t *testing.T) {
+	assert := assert.New(t)
+
+	gbc := GroupedBarChart{
+		Groups: []BarGroup{
+			{Name: "Monday", Values: []Value{{Value: 1.0, Title: "api"}, {Value: 2.0, Title: "web"}, {Value: 3.0, Title: "worker"}}},
+			{Name: "Tuesday", Values: []Value{{Value: 2.0}, {Value: -1.0}, {Value: 4.0}}},
+		},
+	}
+	assert.Equal(3, gbc.getBarsPerGroup())
+	assert.Equal(64, gbc.calculateGroupWidth(gbc.GetBarWidth(), gbc.GetBarSpacing()))
+
+	barWidth, barSpacing, groupSpacing := gbc.calculateScaledWidths(gbc.box())
+	assert.Equal(DefaultGroupedBarWidth, barWidth)
+	assert.Equal(DefaultGroupedBarSpacing, barSpacing)
+	assert.Equal(DefaultGroupSpacing, groupSpacing)
+
+	gbc.BarWidth = 500
+	cb := gbc.box()
+	barWidth, barSpacing, groupSpacing = gbc.calculateScaledWidths(cb)
+	assert.True(barWidth < 500)
+	assert.True(gbc.calculateTotalWidth(barWidth, barSpacing, groupSpacing) <= cb.Width())
+}
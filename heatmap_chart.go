@@ -0,0 +1,649 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultHeatmapColorScaleWidth is the default pixel width of the color scale bar of a heatmap.
+	DefaultHeatmapColorScaleWidth = 16
+	// DefaultHeatmapColorScaleMargin is the default pixel spacing between the canvas and the color scale bar.
+	DefaultHeatmapColorScaleMargin = 20
+)
+
+// HeatmapAxis is an axis of a heatmap chart.
+// Cells are placed along the axis either by category or between continuous values.
+type HeatmapAxis struct {
+	Style Style
+
+	// Categories label the cells along the axis, which are evenly sized.
+	Categories []string
+
+	// Values are the boundaries of the cells along a continuous axis, one more than the number of cells.
+	// If set, the axis is labeled with ticks instead of categories.
+	Values         []float64
+	ValueFormatter ValueFormatter
+	Ticks          []Tick
+}
+
+// IsContinuous returns if the cells are placed between continuous values.
+func (ha HeatmapAxis) IsContinuous() bool {
+	return len(ha.Values) > 0
+}
+
+// GetEdge returns the value of the lower boundary of the cell at a given index.
+func (ha HeatmapAxis) GetEdge(index int) float64 {
+	if ha.IsContinuous() {
+		return ha.Values[index]
+	}
+	return float64(index)
+}
+
+// GetTicks returns the ticks for a continuous axis.
+func (ha HeatmapAxis) GetTicks(r Renderer, ra Range, isVertical bool, defaults Style) []Tick {
+	if len(ha.Ticks) > 0 {
+		return ha.Ticks
+	}
+	vf := ha.ValueFormatter
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	return GenerateContinuousTicks(r, ra, isVertical, ha.Style.InheritFrom(defaults), vf)
+}
+
+// getRange returns the range of the axis over a given number of cells.
+func (ha HeatmapAxis) getRange(cells, domain int) Range {
+	return &ContinuousRange{
+		Min:    ha.GetEdge(0),
+		Max:    ha.GetEdge(cells),
+		Domain: domain,
+	}
+}
+
+// validate validates the axis for a given number of cells.
+func (ha HeatmapAxis) validate(name string, cells int) error {
+	if len(ha.Categories) > 0 && len(ha.Categories) != cells {
+		return fmt.Errorf("heatmap %s axis has %d categories, expected %d", name, len(ha.Categories), cells)
+	}
+	if ha.IsContinuous() {
+		if len(ha.Values) != cells+1 {
+			return fmt.Errorf("heatmap %s axis has %d values, expected %d cell boundaries", name, len(ha.Values), cells+1)
+		}
+		for index := 1; index < len(ha.Values); index++ {
+			if ha.Values[index] <= ha.Values[index-1] {
+				return fmt.Errorf("heatmap %s axis values must be ascending", name)
+			}
+		}
+	}
+	return nil
+}
+
+// HeatmapColorScale is the bar drawn to the right of a heatmap that maps colors to values.
+type HeatmapColorScale struct {
+	Style Style
+
+	// Width is the pixel width of the bar.
+	Width int
+
+	// Range is the range of values mapped onto colors; it defaults to the range of the heatmap values.
+	Range          Range
+	ValueFormatter ValueFormatter
+	Ticks          []Tick
+}
+
+// GetWidth returns the width of the bar or the default.
+func (hcs HeatmapColorScale) GetWidth() int {
+	if hcs.Width == 0 {
+		return DefaultHeatmapColorScaleWidth
+	}
+	return hcs.Width
+}
+
+// HeatmapChart is a chart that draws a two dimensional grid of values as colored cells.
+type HeatmapChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	XAxis HeatmapAxis
+	YAxis HeatmapAxis
+
+	// CellStyle is the style of each cell; the fill color is set by the `ColorProvider`.
+	CellStyle Style
+	// ColorProvider maps the value of a cell to its color; it defaults to `Viridis`.
+	ColorProvider ColorProvider
+	ColorScale    HeatmapColorScale
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	// Values are the cell values indexed by row then column, i.e. `Values[y][x]`.
+	// Rows are drawn from the bottom of the canvas up, and NaN values are left empty.
+	Values   [][]float64
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (hc HeatmapChart) GetDPI(defaults ...float64) float64 {
+	if hc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return hc.DPI
+}
+
+// GetFont returns the text font.
+func (hc HeatmapChart) GetFont() *truetype.Font {
+	if hc.Font == nil {
+		if theme := hc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
+		return hc.defaultFont
+	}
+	return hc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (hc HeatmapChart) GetWidth() int {
+	if hc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return hc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (hc HeatmapChart) GetHeight() int {
+	if hc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return hc.Height
+}
+
+// GetColorProvider returns the color provider for the cells.
+func (hc HeatmapChart) GetColorProvider() ColorProvider {
+	if hc.ColorProvider == nil {
+		return Viridis
+	}
+	return hc.ColorProvider
+}
+
+// GetColumns returns the number of cells in the longest row.
+func (hc HeatmapChart) GetColumns() (columns int) {
+	for _, row := range hc.Values {
+		columns = util.Math.MaxInt(columns, len(row))
+	}
+	return
+}
+
+// GetRows returns the number of rows.
+func (hc HeatmapChart) GetRows() int {
+	return len(hc.Values)
+}
+
+// Validate validates the chart, returning FieldErrors that point at every offending field.
+func (hc HeatmapChart) Validate() error {
+	if hc.GetRows() == 0 || hc.GetColumns() == 0 {
+		return FieldError{Field: "Values", Err: errors.New("please provide at least one row with at least one value")}
+	}
+	var fieldErrors FieldErrors
+	if err := hc.XAxis.validate("x", hc.GetColumns()); err != nil {
+		fieldErrors = append(fieldErrors, FieldError{Field: "XAxis", Err: err})
+	}
+	if err := hc.YAxis.validate("y", hc.GetRows()); err != nil {
+		fieldErrors = append(fieldErrors, FieldError{Field: "YAxis", Err: err})
+	}
+	if len(fieldErrors) > 0 {
+		return fieldErrors
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (hc HeatmapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := hc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(hc.GetWidth(), hc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if hc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		hc.defaultFont = defaultFont
+	}
+	r.SetDPI(hc.GetDPI())
+
+	hc.drawBackground(r)
+
+	cr := hc.getColorRange()
+	canvasBox := hc.getAdjustedCanvasBox(r, hc.box(), cr)
+	xr, yr := hc.getRanges(canvasBox)
+	cr.SetDomain(canvasBox.Height())
+
+	hc.drawCanvas(r, canvasBox)
+	hc.drawCells(r, canvasBox, xr, yr, cr)
+	hc.drawXAxis(r, canvasBox, xr)
+	hc.drawYAxis(r, canvasBox, yr)
+	hc.drawColorScale(r, canvasBox, cr)
+	hc.drawTitle(r)
+
+	for _, a := range hc.Elements {
+		a(r, canvasBox, hc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// RenderInto renders the chart into a box of an existing renderer.
+func (hc HeatmapChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	hc.Width, hc.Height, hc.DPI = box.Width(), box.Height(), hc.GetDPI(r.GetDPI())
+	return hc.Render(BoxRendererProvider(r, box), nil)
+}
+
+func (hc HeatmapChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  hc.GetWidth(),
+		Bottom: hc.GetHeight(),
+	}, hc.getBackgroundStyle())
+}
+
+func (hc HeatmapChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, hc.getCanvasStyle())
+}
+
+// getRanges returns the ranges of the x and y axes across the canvas.
+func (hc HeatmapChart) getRanges(canvasBox Box) (xrange, yrange Range) {
+	return hc.XAxis.getRange(hc.GetColumns(), canvasBox.Width()), hc.YAxis.getRange(hc.GetRows(), canvasBox.Height())
+}
+
+// getColorRange returns the range of values mapped onto colors.
+func (hc HeatmapChart) getColorRange() Range {
+	if hc.ColorScale.Range != nil && !hc.ColorScale.Range.IsZero() {
+		return hc.ColorScale.Range
+	}
+
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for _, row := range hc.Values {
+		for _, v := range row {
+			if !math.IsNaN(v) {
+				min = math.Min(min, v)
+				max = math.Max(max, v)
+			}
+		}
+	}
+
+	if min > max {
+		min, max = 0, 1
+	} else if min == max {
+		max = min + 1
+	}
+	return &ContinuousRange{Min: min, Max: max}
+}
+
+// getCellColor returns the color of a value, clamped to the color range.
+func (hc HeatmapChart) getCellColor(v float64, cr Range) Style {
+	v = math.Max(cr.GetMin(), math.Min(cr.GetMax(), v))
+	return Style{FillColor: hc.GetColorProvider()(v, cr.GetMin(), cr.GetMax())}
+}
+
+func (hc HeatmapChart) drawCells(r Renderer, canvasBox Box, xr, yr, cr Range) {
+	for y, row := range hc.Values {
+		for x, v := range row {
+			if math.IsNaN(v) {
+				continue
+			}
+
+			cellBox := Box{
+				Top:    canvasBox.Bottom - yr.Translate(hc.YAxis.GetEdge(y+1)),
+				Left:   canvasBox.Left + xr.Translate(hc.XAxis.GetEdge(x)),
+				Right:  canvasBox.Left + xr.Translate(hc.XAxis.GetEdge(x+1)),
+				Bottom: canvasBox.Bottom - yr.Translate(hc.YAxis.GetEdge(y)),
+			}
+			Draw.Box(r, cellBox, hc.getCellColor(v, cr).InheritFrom(hc.CellStyle))
+		}
+	}
+}
+
+func (hc HeatmapChart) drawXAxis(r Renderer, canvasBox Box, xr Range) {
+	if !hc.XAxis.Style.Show {
+		return
+	}
+
+	axisStyle := hc.XAxis.Style.InheritFrom(hc.styleDefaultsAxes())
+	axisStyle.WriteToRenderer(r)
+
+	r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom)
+	r.Stroke()
+
+	if hc.XAxis.IsContinuous() {
+		for _, t := range hc.XAxis.GetTicks(r, xr, false, hc.styleDefaultsAxes()) {
+			tx := canvasBox.Left + xr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb := r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, tx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height(), axisStyle)
+		}
+		return
+	}
+
+	for x := 0; x <= hc.GetColumns(); x++ {
+		tx := canvasBox.Left + xr.Translate(hc.XAxis.GetEdge(x))
+
+		axisStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(tx, canvasBox.Bottom)
+		r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+		r.Stroke()
+
+		if x < len(hc.XAxis.Categories) {
+			Draw.TextWithin(r, hc.XAxis.Categories[x], Box{
+				Top:    canvasBox.Bottom + DefaultXAxisMargin,
+				Left:   tx,
+				Right:  canvasBox.Left + xr.Translate(hc.XAxis.GetEdge(x+1)),
+				Bottom: hc.GetHeight(),
+			}, axisStyle)
+		}
+	}
+}
+
+func (hc HeatmapChart) drawYAxis(r Renderer, canvasBox Box, yr Range) {
+	if !hc.YAxis.Style.Show {
+		return
+	}
+
+	axisStyle := hc.YAxis.Style.InheritFrom(hc.styleDefaultsAxes())
+	labelStyle := hc.YAxis.Style.InheritFrom(hc.styleDefaultsVerticalLabels())
+	axisStyle.WriteToRenderer(r)
+
+	r.MoveTo(canvasBox.Left, canvasBox.Top)
+	r.LineTo(canvasBox.Left, canvasBox.Bottom)
+	r.Stroke()
+
+	if hc.YAxis.IsContinuous() {
+		for _, t := range hc.YAxis.GetTicks(r, yr, true, hc.styleDefaultsAxes()) {
+			ty := canvasBox.Bottom - yr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(canvasBox.Left, ty)
+			r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, ty)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb := r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, canvasBox.Left-DefaultYAxisMargin-tb.Width(), ty+(tb.Height()>>1), axisStyle)
+		}
+		return
+	}
+
+	for y := 0; y <= hc.GetRows(); y++ {
+		ty := canvasBox.Bottom - yr.Translate(hc.YAxis.GetEdge(y))
+
+		axisStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(canvasBox.Left, ty)
+		r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, ty)
+		r.Stroke()
+
+		if y < len(hc.YAxis.Categories) {
+			Draw.TextWithin(r, hc.YAxis.Categories[y], Box{
+				Top:    canvasBox.Bottom - yr.Translate(hc.YAxis.GetEdge(y+1)),
+				Left:   0,
+				Right:  canvasBox.Left - DefaultYAxisMargin,
+				Bottom: ty,
+			}, labelStyle)
+		}
+	}
+}
+
+// drawColorScale draws the color scale bar as one band per pixel, with ticks to its right.
+func (hc HeatmapChart) drawColorScale(r Renderer, canvasBox Box, cr Range) {
+	if !hc.ColorScale.Style.Show {
+		return
+	}
+
+	barBox := hc.getColorScaleBox(canvasBox)
+	for y := barBox.Top; y < barBox.Bottom; y++ {
+		// sample the value at the middle of the band.
+		v := cr.GetMin() + (cr.GetMax()-cr.GetMin())*(float64(barBox.Bottom-y)-0.5)/float64(barBox.Height())
+		Draw.Box(r, Box{Top: y, Left: barBox.Left, Right: barBox.Right, Bottom: y + 1}, hc.getCellColor(v, cr))
+	}
+
+	axisStyle := hc.ColorScale.Style.InheritFrom(hc.styleDefaultsAxes())
+	Draw.Box(r, barBox, Style{StrokeColor: axisStyle.GetStrokeColor(), StrokeWidth: axisStyle.GetStrokeWidth()})
+
+	for _, t := range hc.getColorScaleTicks(r, cr) {
+		ty := barBox.Bottom - cr.Translate(t.Value)
+
+		axisStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(barBox.Right, ty)
+		r.LineTo(barBox.Right+DefaultHorizontalTickWidth, ty)
+		r.Stroke()
+
+		axisStyle.GetTextOptions().WriteToRenderer(r)
+		tb := r.MeasureText(t.Label)
+		Draw.Text(r, t.Label, barBox.Right+DefaultYAxisMargin, ty+(tb.Height()>>1), axisStyle)
+	}
+}
+
+func (hc HeatmapChart) getColorScaleBox(canvasBox Box) Box {
+	left := canvasBox.Right + DefaultHeatmapColorScaleMargin
+	return Box{
+		Top:    canvasBox.Top,
+		Left:   left,
+		Right:  left + hc.ColorScale.GetWidth(),
+		Bottom: canvasBox.Bottom,
+	}
+}
+
+func (hc HeatmapChart) getColorScaleTicks(r Renderer, cr Range) []Tick {
+	if len(hc.ColorScale.Ticks) > 0 {
+		return hc.ColorScale.Ticks
+	}
+	vf := hc.ColorScale.ValueFormatter
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	return GenerateContinuousTicks(r, cr, true, hc.ColorScale.Style.InheritFrom(hc.styleDefaultsAxes()), vf)
+}
+
+func (hc HeatmapChart) drawTitle(r Renderer) {
+	if len(hc.Title) > 0 && hc.TitleStyle.Show {
+		titleStyle := hc.TitleStyle.InheritFrom(hc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(hc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(hc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(DefaultTitleFontSize)
+		r.SetFontSize(titleFontSize)
+
+		textBox := r.MeasureText(hc.Title)
+
+		textWidth := textBox.Width()
+		textHeight := textBox.Height()
+
+		titleX := (hc.GetWidth() >> 1) - (textWidth >> 1)
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+
+		r.Text(hc.Title, titleX, titleY)
+	}
+}
+
+// getAdjustedCanvasBox shrinks the chart box to make room for the axis labels and the color scale.
+func (hc HeatmapChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, cr Range) Box {
+	xr, yr := hc.getRanges(canvasBox)
+	cr.SetDomain(canvasBox.Height())
+
+	var left, right, bottom int
+	if hc.YAxis.Style.Show {
+		var labels []string
+		if hc.YAxis.IsContinuous() {
+			labels = hc.getTickLabels(hc.YAxis.GetTicks(r, yr, true, hc.styleDefaultsAxes()))
+		} else {
+			labels = hc.YAxis.Categories
+		}
+		left = hc.measureLabels(r, labels, hc.YAxis.Style.InheritFrom(hc.styleDefaultsAxes())).Width() + DefaultYAxisMargin
+	}
+
+	if hc.XAxis.Style.Show {
+		axisStyle := hc.XAxis.Style.InheritFrom(hc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		var labelHeight int
+		if hc.XAxis.IsContinuous() {
+			labelHeight = hc.measureLabels(r, hc.getTickLabels(hc.XAxis.GetTicks(r, xr, false, hc.styleDefaultsAxes())), axisStyle).Height()
+		} else {
+			cellWidth := (canvasBox.Width() - left) / hc.GetColumns()
+			for _, category := range hc.XAxis.Categories {
+				lines := Text.WrapFit(r, category, cellWidth, axisStyle)
+				labelHeight = util.Math.MaxInt(labelHeight, Text.MeasureLines(r, lines, axisStyle).Height())
+			}
+		}
+		bottom = labelHeight + DefaultXAxisMargin
+	}
+
+	if hc.ColorScale.Style.Show {
+		labels := hc.getTickLabels(hc.getColorScaleTicks(r, cr))
+		right = DefaultHeatmapColorScaleMargin + hc.ColorScale.GetWidth() + DefaultYAxisMargin +
+			hc.measureLabels(r, labels, hc.ColorScale.Style.InheritFrom(hc.styleDefaultsAxes())).Width()
+	}
+
+	return Box{
+		Top:    canvasBox.Top,
+		Left:   canvasBox.Left + left,
+		Right:  canvasBox.Right - right,
+		Bottom: canvasBox.Bottom - bottom,
+	}
+}
+
+func (hc HeatmapChart) getTickLabels(ticks []Tick) (labels []string) {
+	for _, t := range ticks {
+		labels = append(labels, t.Label)
+	}
+	return
+}
+
+// measureLabels returns the size of the largest of a set of single line labels.
+func (hc HeatmapChart) measureLabels(r Renderer, labels []string, style Style) (box Box) {
+	style.GetTextOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	for _, label := range labels {
+		tb := r.MeasureText(label)
+		box.Right = util.Math.MaxInt(box.Right, tb.Width())
+		box.Bottom = util.Math.MaxInt(box.Bottom, tb.Height())
+	}
+	return
+}
+
+// box returns the chart bounds as a box.
+func (hc HeatmapChart) box() Box {
+	padding := hc.GetTheme().BackgroundStyle.Padding
+	dpr := hc.Background.Padding.GetRight(padding.GetRight(20))
+	dpb := hc.Background.Padding.GetBottom(padding.GetBottom(20))
+
+	return Box{
+		Top:    hc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   hc.Background.Padding.GetLeft(padding.GetLeft(20)),
+		Right:  hc.GetWidth() - dpr,
+		Bottom: hc.GetHeight() - dpb,
+	}
+}
+
+func (hc HeatmapChart) getBackgroundStyle() Style {
+	return hc.Background.InheritFrom(hc.styleDefaultsBackground())
+}
+
+func (hc HeatmapChart) getCanvasStyle() Style {
+	return hc.Canvas.InheritFrom(hc.styleDefaultsCanvas())
+}
+
+func (hc HeatmapChart) styleDefaultsBackground() Style {
+	return hc.GetTheme().BackgroundStyle.InheritFrom(Style{
+		FillColor:   hc.GetColorPalette().BackgroundColor(),
+		StrokeColor: hc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+func (hc HeatmapChart) styleDefaultsCanvas() Style {
+	return hc.GetTheme().CanvasStyle.InheritFrom(Style{
+		FillColor:   hc.GetColorPalette().CanvasColor(),
+		StrokeColor: hc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultCanvasStrokeWidth,
+	})
+}
+
+func (hc HeatmapChart) styleDefaultsAxes() Style {
+	return hc.GetTheme().inheritAxisStyle(Style{
+		StrokeColor:         hc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth:         DefaultAxisLineWidth,
+		Font:                hc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           hc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (hc HeatmapChart) styleDefaultsVerticalLabels() Style {
+	return hc.GetTheme().inheritAxisStyle(Style{
+		Font:                hc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           hc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignRight,
+		TextVerticalAlign:   TextVerticalAlignMiddle,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (hc HeatmapChart) styleDefaultsElements() Style {
+	return Style{
+		Font: hc.GetFont(),
+	}
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (hc HeatmapChart) GetColorPalette() ColorPalette {
+	if hc.ColorPalette != nil {
+		return hc.ColorPalette
+	}
+	if theme := hc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// GetTheme returns the theme of the chart or the default theme.
+func (hc HeatmapChart) GetTheme() Theme {
+	if hc.Theme != nil {
+		return *hc.Theme
+	}
+	return DefaultTheme
+}
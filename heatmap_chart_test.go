@@ -0,0 +1,120 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+)
+
+func TestHeatmapChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{
+		XAxis: HeatmapAxis{Style: StyleShow(), Categories: []string{"a", "b", "c"}},
+		YAxis: HeatmapAxis{Style: StyleShow(), Values: []float64{0, 10, 20}},
+		ColorScale: HeatmapColorScale{
+			Style: StyleShow(),
+		},
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, math.NaN(), 6},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(hc.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	buffer.Reset()
+	assert.Nil(hc.Render(SVG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestHeatmapChartRenderInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.NotNil(HeatmapChart{}.Render(PNG, buffer))
+
+	hc := HeatmapChart{
+		XAxis: HeatmapAxis{Categories: []string{"a", "b"}},
+		YAxis: HeatmapAxis{Values: []float64{0, 10, 20}},
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+	}
+	assert.NotNil(hc.Render(PNG, buffer))
+
+	hc.XAxis.Categories = []string{"a", "b", "c"}
+	hc.YAxis.Values = []float64{0, 10}
+	assert.NotNil(hc.Render(PNG, buffer))
+
+	hc.YAxis.Values = []float64{0, 20, 10}
+	assert.NotNil(hc.Render(PNG, buffer))
+}
+
+func TestHeatmapChartColorRange(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, math.NaN(), 6},
+		},
+	}
+	cr := hc.getColorRange()
+	assert.Equal(1.0, cr.GetMin())
+	assert.Equal(6.0, cr.GetMax())
+
+	hc.ColorScale.Range = &ContinuousRange{Min: 2, Max: 4}
+	cr = hc.getColorRange()
+	assert.Equal(2.0, cr.GetMin())
+
+	// values outside of the range are clamped to its colors.
+	assert.Equal(Viridis(2, 2, 4), hc.getCellColor(1, cr).FillColor)
+	assert.Equal(Viridis(4, 2, 4), hc.getCellColor(6, cr).FillColor)
+
+	hc.ColorProvider = func(v, vmin, vmax float64) drawing.Color {
+		return drawing.ColorRed
+	}
+	assert.Equal(drawing.ColorRed, hc.getCellColor(3, cr).FillColor)
+}
+
+func TestHeatmapChartCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := HeatmapChart{
+		Width:  400,
+		Height: 300,
+		XAxis:  HeatmapAxis{Style: StyleShow(), Categories: []string{"a", "b", "c"}},
+		YAxis:  HeatmapAxis{Style: StyleShow(), Values: []float64{0, 10, 20}},
+		ColorScale: HeatmapColorScale{
+			Style: StyleShow(),
+		},
+		Values: [][]float64{
+			{1, 2, 3},
+			{4, math.NaN(), 6},
+		},
+	}
+
+	r, err := PNG(hc.GetWidth(), hc.GetHeight())
+	assert.Nil(err)
+	hc.defaultFont, err = GetDefaultFont()
+	assert.Nil(err)
+
+	box := hc.box()
+	canvasBox := hc.getAdjustedCanvasBox(r, box, hc.getColorRange())
+	assert.Equal(box.Top, canvasBox.Top)
+	assert.True(canvasBox.Left > box.Left)
+	assert.True(canvasBox.Bottom < box.Bottom)
+	assert.True(hc.getColorScaleBox(canvasBox).Right < box.Right)
+
+	xr, yr := hc.getRanges(canvasBox)
+	assert.Equal(3.0, xr.GetMax())
+	assert.Equal(20.0, yr.GetMax())
+	assert.Equal(canvasBox.Width(), xr.Translate(hc.XAxis.GetEdge(3)))
+}
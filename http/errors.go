@@ -0,0 +1,53 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	nethttp "net/http"
+
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/spec"
+)
+
+// Error is the json body of an error response.
+type Error struct {
+	Status  int               `json:"status"`
+	Message string            `json:"message"`
+	Fields  []spec.FieldError `json:"fields,omitempty"`
+}
+
+// Error implements error.
+func (e Error) Error() string {
+	return e.Message
+}
+
+// NewError returns an error response for an error, field errors of specs and charts are listed individually.
+func NewError(status int, err error) Error {
+	output := Error{Status: status, Message: err.Error()}
+
+	var fieldErrors spec.FieldErrors
+	var fieldError spec.FieldError
+	var chartFieldErrors chart.FieldErrors
+	var chartFieldError chart.FieldError
+	if errors.As(err, &fieldErrors) {
+		output.Fields = fieldErrors
+	} else if errors.As(err, &fieldError) {
+		output.Fields = []spec.FieldError{fieldError}
+	} else if errors.As(err, &chartFieldErrors) {
+		for _, fe := range chartFieldErrors {
+			output.Fields = append(output.Fields, spec.FieldError{Field: fe.Field, Message: fe.Err.Error()})
+		}
+	} else if errors.As(err, &chartFieldError) {
+		output.Fields = []spec.FieldError{{Field: chartFieldError.Field, Message: chartFieldError.Err.Error()}}
+	}
+	return output
+}
+
+// WriteError writes an error response as json.
+func WriteError(w nethttp.ResponseWriter, err Error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(err.Status)
+	json.NewEncoder(w).Encode(err)
+}
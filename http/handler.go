@@ -0,0 +1,296 @@
+// Package http serves rendered charts over http.
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/spec"
+)
+
+const (
+	// DefaultMaxWidth is the default upper bound of the `width` query override.
+	DefaultMaxWidth = 4096
+	// DefaultMaxHeight is the default upper bound of the `height` query override.
+	DefaultMaxHeight = 4096
+	// DefaultMaxDPI is the default upper bound of the `dpi` query override.
+	DefaultMaxDPI = 600.0
+)
+
+// Graph is a chart that can render itself, e.g. any `chart.Graph` or a `spec.Chart`.
+type Graph interface {
+	Render(rp chart.RendererProvider, w io.Writer) error
+}
+
+// Validator is a graph that can validate itself before it is rendered.
+type Validator interface {
+	Validate() error
+}
+
+// GraphProvider returns the graph for a request.
+type GraphProvider func(r *nethttp.Request) (Graph, error)
+
+// New returns a handler that renders the graph returned by a provider.
+func New(provider GraphProvider) *Handler {
+	return &Handler{Provider: provider}
+}
+
+// Static returns a handler that always renders the same graph.
+func Static(graph Graph) *Handler {
+	return New(func(_ *nethttp.Request) (Graph, error) {
+		return graph, nil
+	})
+}
+
+// Handler renders charts as png or svg images.
+//
+// The format is picked from the `format` query parameter or the Accept header,
+// and the `width`, `height` and `dpi` query parameters override the chart's own size.
+type Handler struct {
+	Provider GraphProvider
+	Formats  []Format
+
+	// MaxAge is how long clients may cache a rendered chart, zero requires revalidation.
+	MaxAge time.Duration
+
+	MaxWidth  int
+	MaxHeight int
+	MaxDPI    float64
+}
+
+// GetFormats returns the formats the handler can render or the defaults.
+func (h Handler) GetFormats() []Format {
+	if len(h.Formats) == 0 {
+		return []Format{PNG, SVG}
+	}
+	return h.Formats
+}
+
+// GetMaxWidth returns the largest width override or a default.
+func (h Handler) GetMaxWidth() int {
+	if h.MaxWidth == 0 {
+		return DefaultMaxWidth
+	}
+	return h.MaxWidth
+}
+
+// GetMaxHeight returns the largest height override or a default.
+func (h Handler) GetMaxHeight() int {
+	if h.MaxHeight == 0 {
+		return DefaultMaxHeight
+	}
+	return h.MaxHeight
+}
+
+// GetMaxDPI returns the largest dpi override or a default.
+func (h Handler) GetMaxDPI() float64 {
+	if h.MaxDPI == 0 {
+		return DefaultMaxDPI
+	}
+	return h.MaxDPI
+}
+
+// ServeHTTP implements http.Handler.
+func (h Handler) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.Method != nethttp.MethodGet && r.Method != nethttp.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		WriteError(w, Error{Status: nethttp.StatusMethodNotAllowed, Message: fmt.Sprintf("method %s not allowed", r.Method)})
+		return
+	}
+
+	query := r.URL.Query()
+	format, err := h.getFormat(r, query)
+	if err != nil {
+		WriteError(w, NewError(nethttp.StatusBadRequest, err))
+		return
+	}
+	overrides, err := h.getOverrides(query)
+	if err != nil {
+		WriteError(w, NewError(nethttp.StatusBadRequest, err))
+		return
+	}
+
+	graph, err := h.Provider(r)
+	if err != nil {
+		WriteError(w, errorFor(err, nethttp.StatusInternalServerError))
+		return
+	}
+	if graph, err = overrides.apply(graph); err != nil {
+		WriteError(w, NewError(nethttp.StatusBadRequest, err))
+		return
+	}
+	if validator, isValidator := graph.(Validator); isValidator {
+		if err = validator.Validate(); err != nil {
+			WriteError(w, NewError(nethttp.StatusBadRequest, err))
+			return
+		}
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	if err = graph.Render(format.Provider, buffer); err != nil {
+		WriteError(w, errorFor(err, nethttp.StatusInternalServerError))
+		return
+	}
+
+	sum := sha256.Sum256(buffer.Bytes())
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("Vary", "Accept")
+	w.Header().Set("ETag", etag)
+	if h.MaxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.MaxAge/time.Second)))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(nethttp.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(buffer.Len()))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(nethttp.StatusOK)
+	if r.Method != nethttp.MethodHead {
+		w.Write(buffer.Bytes())
+	}
+}
+
+func (h Handler) getFormat(r *nethttp.Request, query url.Values) (Format, error) {
+	formats := h.GetFormats()
+	if name := query.Get("format"); name != "" {
+		var names []string
+		for _, format := range formats {
+			if strings.EqualFold(format.Name, name) {
+				return format, nil
+			}
+			names = append(names, format.Name)
+		}
+		return Format{}, spec.FieldError{Field: "format", Message: fmt.Sprintf("must be one of %s", strings.Join(names, ", "))}
+	}
+	return Negotiate(r.Header.Get("Accept"), formats...), nil
+}
+
+func (h Handler) getOverrides(query url.Values) (overrides, error) {
+	var o overrides
+	var fieldErrors spec.FieldErrors
+	if value := query.Get("width"); value != "" {
+		width, err := strconv.Atoi(value)
+		if err != nil || width <= 0 || width > h.GetMaxWidth() {
+			fieldErrors = append(fieldErrors, spec.FieldError{Field: "width", Message: fmt.Sprintf("must be an integer between 1 and %d", h.GetMaxWidth())})
+		}
+		o.Width = width
+	}
+	if value := query.Get("height"); value != "" {
+		height, err := strconv.Atoi(value)
+		if err != nil || height <= 0 || height > h.GetMaxHeight() {
+			fieldErrors = append(fieldErrors, spec.FieldError{Field: "height", Message: fmt.Sprintf("must be an integer between 1 and %d", h.GetMaxHeight())})
+		}
+		o.Height = height
+	}
+	if value := query.Get("dpi"); value != "" {
+		dpi, err := strconv.ParseFloat(value, 64)
+		if err != nil || !(dpi > 0) || dpi > h.GetMaxDPI() {
+			fieldErrors = append(fieldErrors, spec.FieldError{Field: "dpi", Message: fmt.Sprintf("must be a number between 0 and %v", h.GetMaxDPI())})
+		}
+		o.DPI = dpi
+	}
+	if len(fieldErrors) > 0 {
+		return o, fieldErrors
+	}
+	return o, nil
+}
+
+// overrides are the size overrides of a request.
+type overrides struct {
+	Width  int
+	Height int
+	DPI    float64
+}
+
+// apply returns a copy of the graph with the overrides set on its `Width`, `Height` and `DPI` fields.
+func (o overrides) apply(graph Graph) (Graph, error) {
+	if o.Width == 0 && o.Height == 0 && o.DPI == 0 {
+		return graph, nil
+	}
+
+	value := reflect.ValueOf(graph)
+	isPointer := value.Kind() == reflect.Ptr
+	if isPointer {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T does not support size overrides", graph)
+	}
+
+	copied := reflect.New(value.Type())
+	copied.Elem().Set(value)
+
+	var fieldErrors spec.FieldErrors
+	field := func(query, name string, kind reflect.Kind) reflect.Value {
+		f := copied.Elem().FieldByName(name)
+		if !f.IsValid() || f.Kind() != kind || !f.CanSet() {
+			fieldErrors = append(fieldErrors, spec.FieldError{Field: query, Message: fmt.Sprintf("is not supported by %T", graph)})
+			return reflect.Value{}
+		}
+		return f
+	}
+	if o.Width != 0 {
+		if f := field("width", "Width", reflect.Int); f.IsValid() {
+			f.SetInt(int64(o.Width))
+		}
+	}
+	if o.Height != 0 {
+		if f := field("height", "Height", reflect.Int); f.IsValid() {
+			f.SetInt(int64(o.Height))
+		}
+	}
+	if o.DPI != 0 {
+		if f := field("dpi", "DPI", reflect.Float64); f.IsValid() {
+			f.SetFloat(o.DPI)
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors
+	}
+
+	if isPointer {
+		return copied.Interface().(Graph), nil
+	}
+	return copied.Elem().Interface().(Graph), nil
+}
+
+// errorFor returns the response for an error, keeping the status of an Error
+// and treating field errors as bad requests.
+func errorFor(err error, status int) Error {
+	var typed Error
+	if errors.As(err, &typed) {
+		return typed
+	}
+	output := NewError(status, err)
+	if len(output.Fields) > 0 {
+		output.Status = nethttp.StatusBadRequest
+	}
+	return output
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
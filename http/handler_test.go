@@ -0,0 +1,196 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"image/png"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/spec"
+)
+
+func serve(h nethttp.Handler, method, target string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for index := 0; index+1 < len(headers); index += 2 {
+		req.Header.Set(headers[index], headers[index+1])
+	}
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	return res
+}
+
+func TestHandlerPNG(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Static(chart.Chart{
+		Width:  320,
+		Height: 240,
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	})
+	h.MaxAge = time.Hour
+
+	res := serve(h, "GET", "/chart.png?width=200&height=100")
+	assert.Equal(nethttp.StatusOK, res.Code)
+	assert.Equal(chart.ContentTypePNG, res.Header().Get("Content-Type"))
+	assert.Equal("public, max-age=3600", res.Header().Get("Cache-Control"))
+	assert.Equal("Accept", res.Header().Get("Vary"))
+	assert.NotEmpty(res.Header().Get("ETag"))
+
+	image, err := png.Decode(res.Body)
+	assert.Nil(err)
+	assert.Equal(200, image.Bounds().Dx())
+	assert.Equal(100, image.Bounds().Dy())
+}
+
+func TestHandlerSVG(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Static(&spec.Chart{Series: []spec.Series{{XValues: []float64{1, 2}, YValues: []float64{1, 2}}}})
+
+	res := serve(h, "GET", "/?dpi=72", "Accept", "image/svg+xml")
+	assert.Equal(nethttp.StatusOK, res.Code)
+	assert.Equal(chart.ContentTypeSVG, res.Header().Get("Content-Type"))
+	assert.Equal("no-cache", res.Header().Get("Cache-Control"))
+	assert.True(strings.HasPrefix(res.Body.String(), "<svg"))
+
+	byQuery := serve(h, "GET", "/?dpi=72&format=svg")
+	assert.Equal(res.Header().Get("ETag"), byQuery.Header().Get("ETag"))
+
+	notModified := serve(h, "GET", "/?format=svg&dpi=72", "If-None-Match", res.Header().Get("ETag"))
+	assert.Equal(nethttp.StatusNotModified, notModified.Code)
+	assert.Zero(notModified.Body.Len())
+}
+
+func TestHandlerErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Static(chart.Chart{
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	})
+
+	res := serve(h, "GET", "/?width=0&height=abc&format=gif")
+	assert.Equal(nethttp.StatusBadRequest, res.Code)
+	assert.Equal("application/json; charset=utf-8", res.Header().Get("Content-Type"))
+
+	var body Error
+	assert.Nil(json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(nethttp.StatusBadRequest, body.Status)
+	assert.Len(1, body.Fields)
+	assert.Equal("format", body.Fields[0].Field)
+
+	res = serve(h, "GET", "/?width=0&height=abc")
+	assert.Nil(json.NewDecoder(res.Body).Decode(&body))
+	assert.Len(2, body.Fields)
+	assert.Equal("width", body.Fields[0].Field)
+	assert.Equal("height", body.Fields[1].Field)
+
+	res = serve(h, "POST", "/")
+	assert.Equal(nethttp.StatusMethodNotAllowed, res.Code)
+	assert.Equal("GET, HEAD", res.Header().Get("Allow"))
+
+	res = serve(Static(chart.Chart{}), "GET", "/")
+	assert.Equal(nethttp.StatusBadRequest, res.Code)
+
+	res = serve(New(func(_ *nethttp.Request) (Graph, error) {
+		return nil, Error{Status: nethttp.StatusNotFound, Message: "no such chart"}
+	}), "GET", "/")
+	assert.Equal(nethttp.StatusNotFound, res.Code)
+
+	res = serve(New(func(_ *nethttp.Request) (Graph, error) {
+		return nil, errors.New("boom")
+	}), "GET", "/")
+	assert.Equal(nethttp.StatusInternalServerError, res.Code)
+}
+
+func TestHandlerValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Static(spec.Chart{Series: []spec.Series{{XValues: []float64{1}, YValues: []float64{1}, Style: &spec.Style{FillColor: "nope"}}}})
+
+	res := serve(h, "GET", "/")
+	assert.Equal(nethttp.StatusBadRequest, res.Code)
+
+	var body Error
+	assert.Nil(json.NewDecoder(res.Body).Decode(&body))
+	assert.Len(1, body.Fields)
+	assert.Equal("series[0].style.fillColor", body.Fields[0].Field)
+}
+
+func TestHandlerValidateChart(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Static(chart.Chart{
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+			chart.ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3},
+			},
+		},
+	})
+
+	res := serve(h, "GET", "/")
+	assert.Equal(nethttp.StatusBadRequest, res.Code)
+
+	var body Error
+	assert.Nil(json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(nethttp.StatusBadRequest, body.Status)
+	assert.Len(1, body.Fields)
+	assert.Equal("Series[1]", body.Fields[0].Field)
+	assert.Equal("continuous series has 3 xvalues but 2 yvalues", body.Fields[0].Message)
+
+	res = serve(Static(chart.BarChart{}), "GET", "/")
+	assert.Equal(nethttp.StatusBadRequest, res.Code)
+	assert.Nil(json.NewDecoder(res.Body).Decode(&body))
+	assert.Len(1, body.Fields)
+	assert.Equal("Bars", body.Fields[0].Field)
+}
+
+func TestOverridesApply(t *testing.T) {
+	assert := assert.New(t)
+
+	original := chart.Chart{
+		Width:  320,
+		Height: 240,
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, 3, 2},
+			},
+		},
+	}
+	graph, err := overrides{Width: 100, DPI: 72}.apply(original)
+	assert.Nil(err)
+
+	typed, isTyped := graph.(chart.Chart)
+	assert.True(isTyped)
+	assert.Equal(100, typed.Width)
+	assert.Equal(240, typed.Height)
+	assert.Equal(72.0, typed.DPI)
+	assert.Equal(320, original.Width)
+
+	pointer := &original
+	graph, err = overrides{Height: 50}.apply(pointer)
+	assert.Nil(err)
+	assert.Equal(50, graph.(*chart.Chart).Height)
+	assert.Equal(240, pointer.Height)
+}
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	chart "github.com/daill/go-chart"
+)
+
+const (
+	// FormatPNG is the `format` query value for png images.
+	FormatPNG = "png"
+	// FormatSVG is the `format` query value for svg images.
+	FormatSVG = "svg"
+)
+
+// Format is an output format a chart can be rendered in.
+type Format struct {
+	Name        string
+	ContentType string
+	Provider    chart.RendererProvider
+}
+
+var (
+	// PNG renders charts as png images.
+	PNG = Format{Name: FormatPNG, ContentType: chart.ContentTypePNG, Provider: chart.PNG}
+	// SVG renders charts as svg images.
+	SVG = Format{Name: FormatSVG, ContentType: chart.ContentTypeSVG, Provider: chart.SVG}
+)
+
+// Negotiate picks the format with the highest quality in an Accept header.
+// Ties go to the earlier format, and the first format is used if none are acceptable.
+func Negotiate(accept string, formats ...Format) Format {
+	if len(formats) == 0 {
+		return PNG
+	}
+
+	best, bestQuality := formats[0], 0.0
+	for _, format := range formats {
+		if quality := acceptQuality(accept, format.ContentType); quality > bestQuality {
+			best, bestQuality = format, quality
+		}
+	}
+	return best
+}
+
+// acceptQuality returns the quality an Accept header gives a content type,
+// preferring exact matches over `image/*` over `*/*`.
+func acceptQuality(accept, contentType string) float64 {
+	if strings.TrimSpace(accept) == "" {
+		return 0
+	}
+
+	major := strings.SplitN(contentType, "/", 2)[0]
+	quality, specificity := 0.0, 0
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+
+		var matched int
+		switch mediaType {
+		case contentType:
+			matched = 3
+		case major + "/*":
+			matched = 2
+		case "*/*":
+			matched = 1
+		default:
+			continue
+		}
+		if matched < specificity {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range params[1:] {
+			pieces := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(pieces) == 2 && strings.TrimSpace(pieces[0]) == "q" {
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(pieces[1]), 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		quality, specificity = q, matched
+	}
+	return quality
+}
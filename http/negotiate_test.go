@@ -0,0 +1,29 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	chart "github.com/daill/go-chart"
+)
+
+func TestNegotiate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(FormatPNG, Negotiate("", PNG, SVG).Name)
+	assert.Equal(FormatPNG, Negotiate("*/*", PNG, SVG).Name)
+	assert.Equal(FormatSVG, Negotiate("image/svg+xml", PNG, SVG).Name)
+	assert.Equal(FormatSVG, Negotiate("image/png;q=0.5, image/svg+xml", PNG, SVG).Name)
+	assert.Equal(FormatPNG, Negotiate("image/svg+xml;q=0.8, image/*", PNG, SVG).Name)
+	assert.Equal(FormatSVG, Negotiate("text/html, image/svg+xml;q=0.9, */*;q=0.8", PNG, SVG).Name)
+	assert.Equal(FormatSVG, Negotiate("application/json", SVG, PNG).Name)
+}
+
+func TestAcceptQuality(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0.0, acceptQuality("text/html", chart.ContentTypePNG))
+	assert.Equal(0.3, acceptQuality("*/*;q=0.1, image/*; q=0.3", chart.ContentTypePNG))
+	assert.Equal(0.0, acceptQuality("image/png;q=0, */*", chart.ContentTypePNG))
+	assert.Equal(1.0, acceptQuality("image/png;q=0, */*", chart.ContentTypeSVG))
+}
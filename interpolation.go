@@ -0,0 +1,151 @@
+package chart
+
+import "math"
+
+// Interpolation is how a line series connects its points.
+type Interpolation int
+
+const (
+	// InterpolationLinear connects the points with straight lines, the default.
+	InterpolationLinear Interpolation = iota
+	// InterpolationMonotoneCubic connects the points with a smooth curve that never overshoots
+	// the points, i.e. it doesn't add peaks or dips between them.
+	InterpolationMonotoneCubic
+	// InterpolationCatmullRom connects the points with a smooth curve through every point.
+	InterpolationCatmullRom
+	// InterpolationStepBefore changes to the value of a point at the x of the previous point.
+	InterpolationStepBefore
+	// InterpolationStepAfter holds the value of a point until the x of the next point.
+	InterpolationStepAfter
+	// InterpolationStepMiddle changes to the value of a point half way between the points.
+	InterpolationStepMiddle
+)
+
+// interpolate adds the path between canvas points to the renderer, the path must already be at the first point.
+func interpolate(r Renderer, xs, ys []int, interpolation Interpolation) {
+	if len(xs) < 2 {
+		return
+	}
+
+	switch interpolation {
+	case InterpolationMonotoneCubic:
+		fxs, fys := toFloats(xs), toFloats(ys)
+		tangents := monotoneTangents(fxs, fys)
+		interpolateCubic(r, fxs, fys, func(i int) (c1x, c1y, c2x, c2y float64) {
+			dx := (fxs[i+1] - fxs[i]) / 3
+			return fxs[i] + dx, fys[i] + tangents[i]*dx, fxs[i+1] - dx, fys[i+1] - tangents[i+1]*dx
+		})
+	case InterpolationCatmullRom:
+		fxs, fys := toFloats(xs), toFloats(ys)
+		interpolateCubic(r, fxs, fys, func(i int) (c1x, c1y, c2x, c2y float64) {
+			return catmullRomControlPoints(fxs, fys, i)
+		})
+	case InterpolationStepBefore:
+		for i := 1; i < len(xs); i++ {
+			r.LineTo(xs[i-1], ys[i])
+			r.LineTo(xs[i], ys[i])
+		}
+	case InterpolationStepAfter:
+		for i := 1; i < len(xs); i++ {
+			r.LineTo(xs[i], ys[i-1])
+			r.LineTo(xs[i], ys[i])
+		}
+	case InterpolationStepMiddle:
+		for i := 1; i < len(xs); i++ {
+			mx := (xs[i-1] + xs[i]) >> 1
+			r.LineTo(mx, ys[i-1])
+			r.LineTo(mx, ys[i])
+			r.LineTo(xs[i], ys[i])
+		}
+	default:
+		for i := 1; i < len(xs); i++ {
+			r.LineTo(xs[i], ys[i])
+		}
+	}
+}
+
+// interpolateCubic draws a cubic bezier curve per segment through the control points of the segment,
+// each approximated by two quad curves as the renderers only draw quad curves.
+func interpolateCubic(r Renderer, xs, ys []float64, controlPoints func(i int) (c1x, c1y, c2x, c2y float64)) {
+	for i := 0; i < len(xs)-1; i++ {
+		c1x, c1y, c2x, c2y := controlPoints(i)
+		cubicToQuads(r, xs[i], ys[i], c1x, c1y, c2x, c2y, xs[i+1], ys[i+1])
+	}
+}
+
+func toFloats(values []int) []float64 {
+	floats := make([]float64, len(values))
+	for index, value := range values {
+		floats[index] = float64(value)
+	}
+	return floats
+}
+
+// monotoneTangents returns the tangents at each point for monotone cubic interpolation (Fritsch-Carlson).
+func monotoneTangents(xs, ys []float64) []float64 {
+	n := len(xs)
+	secants := make([]float64, n-1)
+	for i := 0; i < n-1; i++ {
+		if dx := xs[i+1] - xs[i]; dx != 0 {
+			secants[i] = (ys[i+1] - ys[i]) / dx
+		}
+	}
+
+	tangents := make([]float64, n)
+	tangents[0], tangents[n-1] = secants[0], secants[n-2]
+	for i := 1; i < n-1; i++ {
+		if secants[i-1]*secants[i] > 0 {
+			tangents[i] = (secants[i-1] + secants[i]) / 2
+		}
+	}
+
+	// limit the tangents so the curve stays monotone between the points.
+	for i := 0; i < n-1; i++ {
+		if secants[i] == 0 {
+			tangents[i], tangents[i+1] = 0, 0
+			continue
+		}
+		a, b := tangents[i]/secants[i], tangents[i+1]/secants[i]
+		if h := a*a + b*b; h > 9 {
+			t := 3 / math.Sqrt(h)
+			tangents[i], tangents[i+1] = t*a*secants[i], t*b*secants[i]
+		}
+	}
+	return tangents
+}
+
+// catmullRomControlPoints returns the bezier control points of the uniform Catmull-Rom spline
+// segment from point i to point i+1, repeating the end points.
+func catmullRomControlPoints(xs, ys []float64, i int) (c1x, c1y, c2x, c2y float64) {
+	previous, next := i-1, i+2
+	if previous < 0 {
+		previous = 0
+	}
+	if next > len(xs)-1 {
+		next = len(xs) - 1
+	}
+	c1x = xs[i] + (xs[i+1]-xs[previous])/6
+	c1y = ys[i] + (ys[i+1]-ys[previous])/6
+	c2x = xs[i+1] - (xs[next]-xs[i])/6
+	c2y = ys[i+1] - (ys[next]-ys[i])/6
+	return
+}
+
+// cubicToQuads splits a cubic bezier curve in half and draws each half as a quad curve.
+func cubicToQuads(r Renderer, x0, y0, c1x, c1y, c2x, c2y, x3, y3 float64) {
+	// de Casteljau at t = 0.5
+	ax, ay := (x0+c1x)/2, (y0+c1y)/2
+	bx, by := (c1x+c2x)/2, (c1y+c2y)/2
+	cx, cy := (c2x+x3)/2, (c2y+y3)/2
+	abx, aby := (ax+bx)/2, (ay+by)/2
+	bcx, bcy := (bx+cx)/2, (by+cy)/2
+	mx, my := (abx+bcx)/2, (aby+bcy)/2
+
+	quadTo := func(x0, y0, c1x, c1y, c2x, c2y, x3, y3 float64) {
+		qx := (3*(c1x+c2x) - (x0 + x3)) / 4
+		qy := (3*(c1y+c2y) - (y0 + y3)) / 4
+		r.QuadCurveTo(int(math.Round(qx)), int(math.Round(qy)), int(math.Round(x3)), int(math.Round(y3)))
+	}
+	quadTo(x0, y0, ax, ay, abx, aby, mx, my)
+	quadTo(mx, my, bcx, bcy, cx, cy, x3, y3)
+}
@@ -0,0 +1,86 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+)
+
+// pathRecorder records the points a path is drawn through.
+type pathRecorder struct {
+	Renderer
+	xs, ys []int
+}
+
+func (pr *pathRecorder) LineTo(x, y int) {
+	pr.xs, pr.ys = append(pr.xs, x), append(pr.ys, y)
+}
+
+func (pr *pathRecorder) QuadCurveTo(cx, cy, x, y int) {
+	pr.xs, pr.ys = append(pr.xs, cx, x), append(pr.ys, cy, y)
+}
+
+func TestInterpolateSteps(t *testing.T) {
+	assert := assert.New(t)
+
+	xs, ys := []int{0, 10, 20}, []int{10, 0, 5}
+
+	pr := &pathRecorder{}
+	interpolate(pr, xs, ys, InterpolationStepAfter)
+	assert.Equal([]int{10, 10, 20, 20}, pr.xs)
+	assert.Equal([]int{10, 0, 0, 5}, pr.ys)
+
+	pr = &pathRecorder{}
+	interpolate(pr, xs, ys, InterpolationStepBefore)
+	assert.Equal([]int{0, 10, 10, 20}, pr.xs)
+	assert.Equal([]int{0, 0, 5, 5}, pr.ys)
+
+	pr = &pathRecorder{}
+	interpolate(pr, xs, ys, InterpolationStepMiddle)
+	assert.Equal([]int{5, 5, 10, 15, 15, 20}, pr.xs)
+	assert.Equal([]int{10, 0, 0, 0, 5, 5}, pr.ys)
+}
+
+func TestInterpolateMonotoneCubic(t *testing.T) {
+	assert := assert.New(t)
+
+	xs, ys := []int{0, 100, 200, 300}, []int{0, 0, 100, 100}
+
+	pr := &pathRecorder{}
+	interpolate(pr, xs, ys, InterpolationMonotoneCubic)
+	assert.Len(12, pr.xs)
+	assert.Equal(300, pr.xs[len(pr.xs)-1])
+	assert.Equal(100, pr.ys[len(pr.ys)-1])
+	for _, y := range pr.ys {
+		assert.True(y >= 0 && y <= 100)
+	}
+
+	// catmull-rom passes through the points but overshoots the flat parts.
+	pr = &pathRecorder{}
+	interpolate(pr, xs, ys, InterpolationCatmullRom)
+	assert.Equal(100, pr.xs[3])
+	assert.Equal(0, pr.ys[3])
+	assert.Equal(-6, pr.ys[1])
+}
+
+func TestLineSeriesInterpolation(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{Show: true, StrokeColor: drawing.ColorBlue, StrokeWidth: 1, Interpolation: InterpolationMonotoneCubic},
+				XValues: []float64{1, 2, 3, 4},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.True(strings.Contains(buffer.String(), "Q"))
+	assert.Equal(InterpolationMonotoneCubic, Style{}.InheritFrom(c.Series[0].GetStyle()).Interpolation)
+}
@@ -0,0 +1,396 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+// Panel is a chart placed in a cell of a layout.
+type Panel struct {
+	Row    int
+	Column int
+	Graph  Graph
+}
+
+// Layout renders several charts into one image on a grid of rows and columns.
+//
+// The canvas boxes of `Chart` panels are aligned across each row and column, so the
+// plot areas of stacked charts line up even if their axes labels differ in size.
+type Layout struct {
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+
+	// Theme is the look of the background and legend of the layout, and of the `Chart` panels
+	// without a theme of their own. It's `DefaultTheme` if nil.
+	Theme *Theme
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	// RowWeights are the relative heights of the rows, rows without a weight have a weight of 1.
+	RowWeights []float64
+	// ColumnWeights are the relative widths of the columns, columns without a weight have a weight of 1.
+	ColumnWeights []float64
+
+	// SharedXRange gives the `Chart` panels of each column the same x range.
+	SharedXRange bool
+
+	// Legend shows a single legend of the series of every `Chart` panel below the grid.
+	Legend Style
+
+	Panels []Panel
+}
+
+// GetDPI returns the dpi for the layout.
+func (l Layout) GetDPI(defaults ...float64) float64 {
+	if l.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return l.DPI
+}
+
+// GetFont returns the text font.
+func (l Layout) GetFont() *truetype.Font {
+	if l.Font == nil {
+		if theme := l.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
+		return l.defaultFont
+	}
+	return l.Font
+}
+
+// GetWidth returns the layout width or the default value.
+func (l Layout) GetWidth() int {
+	if l.Width == 0 {
+		return DefaultChartWidth
+	}
+	return l.Width
+}
+
+// GetHeight returns the layout height or the default value.
+func (l Layout) GetHeight() int {
+	if l.Height == 0 {
+		return DefaultChartHeight
+	}
+	return l.Height
+}
+
+// GetRows returns the number of rows.
+func (l Layout) GetRows() int {
+	rows := len(l.RowWeights)
+	for _, p := range l.Panels {
+		rows = util.Math.MaxInt(rows, p.Row+1)
+	}
+	return rows
+}
+
+// GetColumns returns the number of columns.
+func (l Layout) GetColumns() int {
+	columns := len(l.ColumnWeights)
+	for _, p := range l.Panels {
+		columns = util.Math.MaxInt(columns, p.Column+1)
+	}
+	return columns
+}
+
+// Render renders the layout with the given renderer to the given io.Writer.
+func (l Layout) Render(rp RendererProvider, w io.Writer) error {
+	if len(l.Panels) == 0 {
+		return errors.New("please provide at least one panel")
+	}
+	for _, p := range l.Panels {
+		if p.Graph == nil {
+			return errors.New("please provide a graph for every panel")
+		}
+		if p.Row < 0 || p.Column < 0 {
+			return errors.New("panel rows and columns must not be negative")
+		}
+	}
+
+	r, err := rp(l.GetWidth(), l.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if l.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		l.defaultFont = defaultFont
+	}
+	r.SetDPI(l.GetDPI(DefaultDPI))
+
+	Draw.Box(r, Box{Right: l.GetWidth(), Bottom: l.GetHeight()}, l.Background.InheritFrom(l.styleDefaultsBackground()))
+
+	legend := newChartLegend(LegendOptions{Style: l.Legend, Entries: l.getLegendEntries()}, nil, l.styleDefaultsLegend())
+	grid := legend.adjustCanvasBox(r, l.Box())
+
+	cells := l.getCellBoxes(grid)
+	graphs, err := l.getPanelGraphs(r, cells)
+	if err != nil {
+		r.Save(w)
+		return err
+	}
+	for index, graph := range graphs {
+		r.SetDPI(l.GetDPI(DefaultDPI))
+		if err = graph.RenderInto(r, cells[index]); err != nil {
+			r.Save(w)
+			return err
+		}
+	}
+	r.SetDPI(l.GetDPI(DefaultDPI))
+
+	legend.draw(r, l.Box(), grid)
+	return r.Save(w)
+}
+
+// RenderInto renders the layout into a box of an existing renderer.
+func (l Layout) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	l.Width, l.Height, l.DPI = box.Width(), box.Height(), l.GetDPI(r.GetDPI())
+	return l.Render(BoxRendererProvider(r, box), nil)
+}
+
+// Box returns the bounds of the grid and the legend as a box.
+func (l Layout) Box() Box {
+	return Box{
+		Top:    l.Background.Padding.Top,
+		Left:   l.Background.Padding.Left,
+		Right:  l.GetWidth() - l.Background.Padding.Right,
+		Bottom: l.GetHeight() - l.Background.Padding.Bottom,
+	}
+}
+
+// getCellBoxes returns the box of each panel.
+func (l Layout) getCellBoxes(grid Box) []Box {
+	rows := l.getOffsets(grid.Top, grid.Height(), l.RowWeights, l.GetRows())
+	columns := l.getOffsets(grid.Left, grid.Width(), l.ColumnWeights, l.GetColumns())
+
+	cells := make([]Box, len(l.Panels))
+	for index, p := range l.Panels {
+		cells[index] = Box{
+			Top:    rows[p.Row],
+			Left:   columns[p.Column],
+			Right:  columns[p.Column+1],
+			Bottom: rows[p.Row+1],
+		}
+	}
+	return cells
+}
+
+// getOffsets splits a length into weighted parts and returns the count+1 part boundaries.
+func (l Layout) getOffsets(start, length int, weights []float64, count int) []int {
+	var total float64
+	for index := 0; index < count; index++ {
+		total += l.getWeight(weights, index)
+	}
+
+	offsets := make([]int, count+1)
+	var cursor float64
+	for index := 0; index < count; index++ {
+		offsets[index] = start + int(math.Round(float64(length)*cursor/total))
+		cursor += l.getWeight(weights, index)
+	}
+	offsets[count] = start + length
+	return offsets
+}
+
+func (l Layout) getWeight(weights []float64, index int) float64 {
+	if index < len(weights) && weights[index] > 0 {
+		return weights[index]
+	}
+	return 1
+}
+
+// getPanelGraphs returns the graph of each panel, with the `Chart` panels sized to their
+// cells and their x ranges and canvas boxes shared across rows and columns.
+func (l Layout) getPanelGraphs(r Renderer, cells []Box) ([]Graph, error) {
+	graphs := make([]Graph, len(l.Panels))
+	charts := map[int]Chart{}
+	for index, p := range l.Panels {
+		graphs[index] = p.Graph
+		switch typed := p.Graph.(type) {
+		case Chart:
+			charts[index] = typed
+		case *Chart:
+			charts[index] = *typed
+		}
+	}
+	if len(charts) == 0 {
+		return graphs, nil
+	}
+
+	if l.SharedXRange {
+		l.shareXRanges(charts)
+	}
+
+	// measure the canvas box each chart would use on its own.
+	canvases := map[int]Box{}
+	for index, c := range charts {
+		c.Width, c.Height = cells[index].Width(), cells[index].Height()
+		c.DPI = c.GetDPI(l.GetDPI(DefaultDPI))
+		if c.Font == nil {
+			c.defaultFont = l.GetFont()
+		}
+		if c.Theme == nil {
+			c.Theme = l.Theme
+		}
+		if len(c.Series) == 0 {
+			return nil, errors.New("please provide at least one series")
+		}
+
+		r.SetDPI(c.GetDPI())
+		canvasBox, _, _, _, _, _, _, err := c.layout(r)
+		if err != nil {
+			return nil, err
+		}
+		charts[index] = c
+		canvases[index] = canvasBox
+	}
+
+	// inset by the largest margins of each row and column.
+	top, bottom := map[int]int{}, map[int]int{}
+	left, right := map[int]int{}, map[int]int{}
+	for index, canvasBox := range canvases {
+		p, cell := l.Panels[index], cells[index]
+		top[p.Row] = util.Math.MaxInt(top[p.Row], canvasBox.Top)
+		bottom[p.Row] = util.Math.MaxInt(bottom[p.Row], cell.Height()-canvasBox.Bottom)
+		left[p.Column] = util.Math.MaxInt(left[p.Column], canvasBox.Left)
+		right[p.Column] = util.Math.MaxInt(right[p.Column], cell.Width()-canvasBox.Right)
+	}
+	for index, c := range charts {
+		p, cell := l.Panels[index], cells[index]
+		c.canvasBox = Box{
+			Top:    top[p.Row],
+			Left:   left[p.Column],
+			Right:  cell.Width() - right[p.Column],
+			Bottom: cell.Height() - bottom[p.Row],
+		}
+		graphs[index] = c
+	}
+	return graphs, nil
+}
+
+// shareXRanges sets the x range of every chart to the union of the x ranges in its column.
+func (l Layout) shareXRanges(charts map[int]Chart) {
+	mins, maxes := map[int]float64{}, map[int]float64{}
+	ranges := map[int]Range{}
+	for index, c := range charts {
+		column := l.Panels[index].Column
+		xr, _, _ := c.getRanges()
+		ranges[index] = xr
+		if _, ok := mins[column]; !ok {
+			mins[column], maxes[column] = xr.GetMin(), xr.GetMax()
+			continue
+		}
+		mins[column] = math.Min(mins[column], xr.GetMin())
+		maxes[column] = math.Max(maxes[column], xr.GetMax())
+	}
+
+	for index, c := range charts {
+		column := l.Panels[index].Column
+		c.XAxis.Range = cloneRange(ranges[index], mins[column], maxes[column])
+		charts[index] = c
+	}
+}
+
+// cloneRange returns a copy of a range with the given bounds, so the range of the chart
+// isn't changed and its kind, location, intervals etc. are kept. Ranges of other types
+// are replaced by a continuous range.
+func cloneRange(ra Range, min, max float64) Range {
+	var clone Range
+	switch typed := ra.(type) {
+	case *ContinuousRange:
+		copied := *typed
+		clone = &copied
+	case *LogarithmicRange:
+		copied := *typed
+		clone = &copied
+	case *MarketHoursRange:
+		copied := *typed
+		clone = &copied
+	case *NiceRange:
+		copied := *typed
+		clone = &copied
+	case *TimeRange:
+		copied := *typed
+		clone = &copied
+	default:
+		clone = &ContinuousRange{Descending: ra.IsDescending()}
+	}
+	clone.SetMin(min)
+	clone.SetMax(max)
+	return clone
+}
+
+// getLegendEntries returns the legend entries of every chart panel, once per label.
+func (l Layout) getLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	seen := map[string]bool{}
+	for _, p := range l.Panels {
+		var c Chart
+		switch typed := p.Graph.(type) {
+		case Chart:
+			c = typed
+		case *Chart:
+			c = *typed
+		default:
+			continue
+		}
+		if c.Theme == nil {
+			c.Theme = l.Theme
+		}
+		for _, entry := range c.GetLegendEntries() {
+			if entry.Label == "" || seen[entry.Label] {
+				continue
+			}
+			seen[entry.Label] = true
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (l Layout) styleDefaultsBackground() Style {
+	return l.GetTheme().BackgroundStyle.InheritFrom(Style{
+		FillColor:   l.getColorPalette().BackgroundColor(),
+		StrokeColor: l.getColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultBackgroundStrokeWidth,
+	})
+}
+
+func (l Layout) styleDefaultsLegend() Style {
+	return l.GetTheme().LegendStyle.InheritFrom(Style{
+		Font:      l.GetFont(),
+		FontColor: l.getColorPalette().TextColor(),
+		FontSize:  DefaultLegendFontSize,
+		Padding:   DefaultLegendPadding,
+	})
+}
+
+// GetTheme returns the theme of the layout or the default theme.
+func (l Layout) GetTheme() Theme {
+	if l.Theme != nil {
+		return *l.Theme
+	}
+	return DefaultTheme
+}
+
+func (l Layout) getColorPalette() ColorPalette {
+	if theme := l.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
+	return DefaultColorPalette
+}
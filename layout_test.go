@@ -0,0 +1,199 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/util"
+)
+
+func TestLayoutGetCellBoxes(t *testing.T) {
+	assert := assert.New(t)
+
+	l := Layout{
+		RowWeights: []float64{3, 1},
+		Panels: []Panel{
+			{Row: 0, Column: 0},
+			{Row: 1, Column: 0},
+			{Row: 1, Column: 1},
+		},
+	}
+	assert.Equal(2, l.GetRows())
+	assert.Equal(2, l.GetColumns())
+
+	cells := l.getCellBoxes(Box{Right: 200, Bottom: 400})
+	assert.Equal(Box{Top: 0, Left: 0, Right: 100, Bottom: 300}, cells[0])
+	assert.Equal(Box{Top: 300, Left: 0, Right: 100, Bottom: 400}, cells[1])
+	assert.Equal(Box{Top: 300, Left: 100, Right: 200, Bottom: 400}, cells[2])
+}
+
+func TestLayoutSharedXRangeAndAlignment(t *testing.T) {
+	assert := assert.New(t)
+
+	top := Chart{
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow(), Name: "Price", NameStyle: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "Price", XValues: []float64{1, 2, 3}, YValues: []float64{100, 101, 102}},
+		},
+	}
+	bottom := Chart{
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "Volume", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "Price", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+		},
+	}
+	l := Layout{
+		SharedXRange: true,
+		Panels:       []Panel{{Row: 0, Graph: top}, {Row: 1, Graph: &bottom}},
+	}
+
+	r, err := PNG(l.GetWidth(), l.GetHeight())
+	assert.Nil(err)
+	l.defaultFont, err = GetDefaultFont()
+	assert.Nil(err)
+
+	cells := l.getCellBoxes(l.Box())
+	graphs, err := l.getPanelGraphs(r, cells)
+	assert.Nil(err)
+	assert.Len(2, graphs)
+
+	topChart, bottomChart := graphs[0].(Chart), graphs[1].(Chart)
+	assert.Equal(0.0, topChart.XAxis.Range.GetMin())
+	assert.Equal(4.0, topChart.XAxis.Range.GetMax())
+	assert.Equal(0.0, bottomChart.XAxis.Range.GetMin())
+	assert.Equal(4.0, bottomChart.XAxis.Range.GetMax())
+	assert.Nil(bottom.XAxis.Range)
+
+	assert.False(topChart.canvasBox.IsZero())
+	assert.Equal(topChart.canvasBox.Left, bottomChart.canvasBox.Left)
+	assert.Equal(topChart.canvasBox.Right, bottomChart.canvasBox.Right)
+}
+
+func TestLayoutLegendEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	top := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "Price", XValues: []float64{1, 2, 3}, YValues: []float64{100, 101, 102}},
+		},
+	}
+	bottom := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "Volume", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "Price", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+		},
+	}
+	l := Layout{Panels: []Panel{{Row: 0, Graph: top}, {Row: 1, Graph: bottom}}}
+
+	entries := l.getLegendEntries()
+	assert.Len(2, entries)
+	assert.Equal("Price", entries[0].Label)
+	assert.Equal("Volume", entries[1].Label)
+	assert.Equal(bottom.GetColorPalette().GetSeriesColor(0), entries[1].Style.StrokeColor)
+}
+
+func TestLayoutRender(t *testing.T) {
+	assert := assert.New(t)
+
+	top := Chart{
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow(), Name: "Price", NameStyle: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "Price", XValues: []float64{1, 2, 3}, YValues: []float64{100, 101, 102}},
+		},
+	}
+	bottom := Chart{
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "Volume", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "Price", XValues: []float64{0, 4}, YValues: []float64{1, 2}},
+		},
+	}
+	l := Layout{
+		Width:         800,
+		Height:        600,
+		ColumnWeights: []float64{2, 1},
+		SharedXRange:  true,
+		Legend:        StyleShow(),
+		Panels: []Panel{
+			{Row: 0, Column: 0, Graph: top},
+			{Row: 1, Column: 0, Graph: bottom},
+			{Row: 0, Column: 1, Graph: PieChart{Values: []Value{{Value: 1}, {Value: 2}}}},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(l.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	assert.NotNil(Layout{}.Render(PNG, buffer))
+	assert.NotNil(Layout{Panels: []Panel{{Row: -1, Graph: top}}}.Render(PNG, buffer))
+}
+
+func TestLayoutSharedTimeRange(t *testing.T) {
+	assert := assert.New(t)
+
+	location, err := time.LoadLocation("America/New_York")
+	assert.Nil(err)
+	start := time.Date(2018, 01, 02, 9, 30, 0, 0, location)
+
+	price := Chart{
+		XAxis: XAxis{Style: StyleShow(), Range: &TimeRange{Location: location, WeekStart: time.Monday}},
+		Series: []Series{
+			TimeSeries{XValues: []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)}, YValues: []float64{100, 101, 102}},
+		},
+	}
+	macd := Chart{
+		XAxis: XAxis{Style: StyleShow(), Range: &TimeRange{Location: location, WeekStart: time.Monday}},
+		Series: []Series{
+			TimeSeries{XValues: []time.Time{start.AddDate(0, 0, 1), start.AddDate(0, 0, 4)}, YValues: []float64{-1, 1}},
+		},
+	}
+	l := Layout{
+		SharedXRange: true,
+		Panels:       []Panel{{Row: 0, Graph: price}, {Row: 1, Graph: macd}},
+	}
+
+	r, err := PNG(l.GetWidth(), l.GetHeight())
+	assert.Nil(err)
+	l.defaultFont, err = GetDefaultFont()
+	assert.Nil(err)
+
+	graphs, err := l.getPanelGraphs(r, l.getCellBoxes(l.Box()))
+	assert.Nil(err)
+	for _, graph := range graphs {
+		tr, isTimeRange := graph.(Chart).XAxis.Range.(*TimeRange)
+		assert.True(isTimeRange)
+		assert.Equal(location, tr.Location)
+		assert.Equal(time.Monday, tr.WeekStart)
+		assert.True(tr.GetMinTime().Equal(start))
+		assert.True(tr.GetMaxTime().Equal(start.AddDate(0, 0, 4)))
+	}
+	assert.True(price.XAxis.Range.(*TimeRange).GetMaxTime().Before(start.AddDate(0, 0, 4)))
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(l.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestCloneRange(t *testing.T) {
+	assert := assert.New(t)
+
+	nice := &NiceRange{TickCount: 5, Descending: true}
+	clone, isNice := cloneRange(nice, 1, 9).(*NiceRange)
+	assert.True(isNice)
+	assert.Equal(5, clone.TickCount)
+	assert.True(clone.Descending)
+	assert.Equal(9.0, clone.Max)
+	assert.Zero(nice.Max)
+
+	mhr := &MarketHoursRange{MarketOpen: util.NYSEOpen(), MarketClose: util.NYSEClose(), HolidayProvider: util.Date.IsNYSEHoliday}
+	_, isMarketHours := cloneRange(mhr, mhr.GetMin(), mhr.GetMax()).(*MarketHoursRange)
+	assert.True(isMarketHours)
+}
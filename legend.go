@@ -1,20 +1,127 @@
 package chart
 
 import (
-	"github.com/daill/go-chart/drawing"
+	"math"
+
 	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultLegendFontSize is the font size of legend labels.
+	DefaultLegendFontSize = 8.0
+	// DefaultLegendLineLength is the length of the line marker drawn before a legend label.
+	DefaultLegendLineLength = 25
+	// DefaultLegendMarkerTextGap is the gap between a legend marker and its label.
+	DefaultLegendMarkerTextGap = 5
+	// DefaultLegendRowSpacing is the vertical gap between legend rows.
+	DefaultLegendRowSpacing = 5
+	// DefaultLegendColumnSpacing is the horizontal gap between legend columns.
+	DefaultLegendColumnSpacing = 20
+	// DefaultLegendMargin is the gap between a legend and the canvas.
+	DefaultLegendMargin = 10
 )
 
+var (
+	// DefaultLegendPadding is the padding between the border of a legend and its entries.
+	DefaultLegendPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
+)
+
+// LegendMarker is the marker drawn before the label of a legend entry.
+type LegendMarker int
+
+const (
+	// LegendMarkerLine draws the line of a series with one of its dots if it draws dots, the default.
+	LegendMarkerLine LegendMarker = iota
+	// LegendMarkerBox draws a filled square, i.e. for bars and slices.
+	LegendMarkerBox
+	// LegendMarkerDot draws a single dot, i.e. for bubbles and series that only draw dots.
+	LegendMarkerDot
+)
+
+// LegendPlacement is where a legend is drawn.
+type LegendPlacement int
+
+const (
+	// LegendPlacementBottom draws the legend below the canvas, the default.
+	LegendPlacementBottom LegendPlacement = iota
+	// LegendPlacementTop draws the legend above the canvas.
+	LegendPlacementTop
+	// LegendPlacementLeft draws the legend left of the canvas.
+	LegendPlacementLeft
+	// LegendPlacementRight draws the legend right of the canvas.
+	LegendPlacementRight
+	// LegendPlacementInsideTopLeft draws the legend over the top left corner of the canvas.
+	LegendPlacementInsideTopLeft
+	// LegendPlacementInsideTopRight draws the legend over the top right corner of the canvas.
+	LegendPlacementInsideTopRight
+	// LegendPlacementInsideBottomLeft draws the legend over the bottom left corner of the canvas.
+	LegendPlacementInsideBottomLeft
+	// LegendPlacementInsideBottomRight draws the legend over the bottom right corner of the canvas.
+	LegendPlacementInsideBottomRight
+)
+
+// IsInside returns if the legend is drawn over the canvas.
+func (lp LegendPlacement) IsInside() bool {
+	return lp >= LegendPlacementInsideTopLeft
+}
+
+// IsHorizontal returns if the legend is drawn above or below the canvas, where its entries
+// are laid out in rows across the chart.
+func (lp LegendPlacement) IsHorizontal() bool {
+	return lp == LegendPlacementBottom || lp == LegendPlacementTop
+}
+
+// LegendEntry is a label in a legend and the marker of what it labels.
+type LegendEntry struct {
+	Label  string
+	Style  Style
+	Marker LegendMarker
+}
+
+// LegendEntriesProvider is a chart that lists the entries of its legend.
+type LegendEntriesProvider interface {
+	GetLegendEntries() []LegendEntry
+}
+
+// LegendOptions are the options of the legend of a chart, which is drawn if `Style.Show` is set.
+// A legend outside of the canvas shrinks the canvas to make room for it.
+type LegendOptions struct {
+	Style     Style
+	Placement LegendPlacement
+	// Columns is the number of columns the entries are wrapped into. By default a legend above or
+	// below the canvas has as many columns as fit the width, and any other legend as few as fit the height.
+	Columns int
+	// Entries replace the entries of the chart if set.
+	Entries []LegendEntry
+}
+
+// GetEntries returns the labeled entries of the options, or of the chart if there are none.
+func (lo LegendOptions) GetEntries(provider LegendEntriesProvider) []LegendEntry {
+	entries := lo.Entries
+	if len(entries) == 0 && provider != nil {
+		entries = provider.GetLegendEntries()
+	}
+
+	var labeled []LegendEntry
+	for _, entry := range entries {
+		if len(entry.Label) > 0 {
+			labeled = append(labeled, entry)
+		}
+	}
+	return labeled
+}
+
 // Legend returns a legend renderable function.
 func Legend(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
-		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+		legendDefaults := c.GetTheme().LegendStyle.InheritFrom(Style{
+			FillColor:   c.GetColorPalette().BackgroundColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
-		}
+		})
 
 		var legendStyle Style
 		if len(userDefaults) > 0 {
@@ -104,13 +211,7 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 				ly := ty - th2
 				lx2 := legendContent.Right - legendPadding.Right
 
-				r.SetStrokeColor(lines[x].GetStrokeColor())
-				r.SetStrokeWidth(lines[x].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
-
-				r.MoveTo(lx, ly)
-				r.LineTo(lx2, ly)
-				r.Stroke()
+				drawLegendSwatch(r, lx, lx2, ly, th2, lines[x])
 
 				ycursor += tb.Height()
 				legendCount++
@@ -119,34 +220,9 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 	}
 }
 
-// LegendThin is a legend that doesn't obscure the chart area.
+// LegendBarChart is a legend that doesn't obscure the chart area.
 func LegendBarChart(sbc *StackedValueBarChart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
-		legendDefaults := Style{
-			FillColor:   ColorWhite,
-			FontColor:   DefaultTextColor,
-			FontSize:    8.0,
-			StrokeColor: ColorBlack,
-			StrokeWidth: DefaultAxisLineWidth,
-			Padding: Box{
-				Top:    2,
-				Left:   7,
-				Right:  7,
-				Bottom: 5,
-			},
-		}
-
-		var legendStyle Style
-		if len(userDefaults) > 0 {
-			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
-		} else {
-			legendStyle = chartDefaults.InheritFrom(legendDefaults)
-		}
-
-		r.SetFont(legendStyle.GetFont())
-		r.SetFontColor(legendStyle.GetFontColor())
-		r.SetFontSize(legendStyle.GetFontSize())
-
 		var labels []string
 		var lines []Style
 		for _, b := range sbc.Bars {
@@ -160,61 +236,110 @@ func LegendBarChart(sbc *StackedValueBarChart, userDefaults ...Style) Renderable
 			}
 		}
 
-		var textHeight int
-		var textWidth int
-		var textBox Box
-		for x := 0; x < len(labels); x++ {
-			if len(labels[x]) > 0 {
-				textBox = r.MeasureText(labels[x])
-				textHeight = util.Math.MaxInt(textBox.Height(), textHeight)
-				textWidth = util.Math.MaxInt(textBox.Width(), textWidth)
+		legendBar(r, cb, chartDefaults, sbc.GetTheme(), sbc.GetColorPalette(), labels, lines, userDefaults...)
+	}
+}
+
+// LegendGroupedBarChart is a legend for a grouped bar chart that doesn't obscure the chart area.
+// The labels are the titles of the values of the first group.
+func LegendGroupedBarChart(gbc *GroupedBarChart, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		var labels []string
+		var lines []Style
+		if len(gbc.Groups) > 0 {
+			for index, bv := range gbc.Groups[0].Values {
+				if bv.Style.IsZero() || bv.Style.Show {
+					labels = append(labels, bv.Title)
+					lines = append(lines, bv.Style.InheritFrom(gbc.styleDefaultsBar(index)))
+				}
 			}
 		}
 
-		legendBoxHeight := textHeight + legendStyle.Padding.Top + legendStyle.Padding.Bottom
-		chartPadding := cb.Top
-		legendYMargin := (chartPadding - legendBoxHeight) >> 1
+		legendBar(r, cb, chartDefaults, gbc.GetTheme(), gbc.GetColorPalette(), labels, lines, userDefaults...)
+	}
+}
 
-		legendBox := Box{
-			Left:   cb.Left,
-			Right:  cb.Right,
-			Top:    legendYMargin,
-			Bottom: legendYMargin + legendBoxHeight,
+// legendBar draws a single row of labels and line swatches above the canvas.
+func legendBar(r Renderer, cb Box, chartDefaults Style, theme Theme, cp ColorPalette, labels []string, lines []Style, userDefaults ...Style) {
+	legendDefaults := theme.LegendStyle.InheritFrom(Style{
+		FillColor:   cp.BackgroundColor(),
+		FontColor:   cp.TextColor(),
+		FontSize:    8.0,
+		StrokeColor: cp.AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		Padding: Box{
+			Top:    2,
+			Left:   7,
+			Right:  7,
+			Bottom: 5,
+		},
+	})
+
+	var legendStyle Style
+	if len(userDefaults) > 0 {
+		legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+	} else {
+		legendStyle = chartDefaults.InheritFrom(legendDefaults)
+	}
+
+	r.SetFont(legendStyle.GetFont())
+	r.SetFontColor(legendStyle.GetFontColor())
+	r.SetFontSize(legendStyle.GetFontSize())
+
+	var textHeight int
+	var textWidth int
+	var textBox Box
+	for x := 0; x < len(labels); x++ {
+		if len(labels[x]) > 0 {
+			textBox = r.MeasureText(labels[x])
+			textHeight = util.Math.MaxInt(textBox.Height(), textHeight)
+			textWidth = util.Math.MaxInt(textBox.Width(), textWidth)
 		}
+	}
 
-		Draw.Box(r, legendBox, legendDefaults)
+	legendBoxHeight := textHeight + legendStyle.Padding.Top + legendStyle.Padding.Bottom
+	chartPadding := cb.Top
+	legendYMargin := (chartPadding - legendBoxHeight) >> 1
 
-		r.SetFont(legendStyle.GetFont())
-		r.SetFontColor(legendStyle.GetFontColor())
-		r.SetFontSize(legendStyle.GetFontSize())
+	legendBox := Box{
+		Left:   cb.Left,
+		Right:  cb.Right,
+		Top:    legendYMargin,
+		Bottom: legendYMargin + legendBoxHeight,
+	}
 
-		lineTextGap := 5
-		lineLengthMinimum := 25
+	Draw.Box(r, legendBox, legendDefaults)
 
-		tx := legendBox.Left + legendStyle.Padding.Left
-		ty := legendYMargin + legendStyle.Padding.Top + textHeight
-		var label string
-		var lx, ly int
-		th2 := textHeight >> 1
-		for index := range labels {
-			label = labels[index]
-			if len(label) > 0 {
-				textBox = r.MeasureText(label)
-				r.Text(label, tx, ty)
+	r.SetFont(legendStyle.GetFont())
+	r.SetFontColor(legendStyle.GetFontColor())
+	r.SetFontSize(legendStyle.GetFontSize())
 
-				lx = tx + textBox.Width() + lineTextGap
-				ly = ty - th2
+	lineTextGap := 5
+	lineLengthMinimum := 25
 
-				r.SetStrokeColor(lines[index].GetStrokeColor())
-				r.SetStrokeWidth(lines[index].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[index].GetStrokeDashArray())
+	tx := legendBox.Left + legendStyle.Padding.Left
+	ty := legendYMargin + legendStyle.Padding.Top + textHeight
+	var label string
+	var lx, ly int
+	th2 := textHeight >> 1
+	for index := range labels {
+		label = labels[index]
+		if len(label) > 0 {
+			textBox = r.MeasureText(label)
+			r.Text(label, tx, ty)
 
-				r.MoveTo(lx, ly)
-				r.LineTo(lx+lineLengthMinimum, ly)
-				r.Stroke()
+			lx = tx + textBox.Width() + lineTextGap
+			ly = ty - th2
 
-				tx += textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
-			}
+			r.SetStrokeColor(lines[index].GetStrokeColor())
+			r.SetStrokeWidth(lines[index].GetStrokeWidth())
+			r.SetStrokeDashArray(lines[index].GetStrokeDashArray())
+
+			r.MoveTo(lx, ly)
+			r.LineTo(lx+lineLengthMinimum, ly)
+			r.Stroke()
+
+			tx += textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
 		}
 	}
 }
@@ -222,11 +347,11 @@ func LegendBarChart(sbc *StackedValueBarChart, userDefaults ...Style) Renderable
 // LegendThin is a legend that doesn't obscure the chart area.
 func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
-		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+		legendDefaults := c.GetTheme().LegendStyle.InheritFrom(Style{
+			FillColor:   c.GetColorPalette().BackgroundColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
 			Padding: Box{
 				Top:    2,
@@ -234,7 +359,7 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 				Right:  7,
 				Bottom: 5,
 			},
-		}
+		})
 
 		var legendStyle Style
 		if len(userDefaults) > 0 {
@@ -303,13 +428,7 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 				lx = tx + textBox.Width() + lineTextGap
 				ly = ty - th2
 
-				r.SetStrokeColor(lines[index].GetStrokeColor())
-				r.SetStrokeWidth(lines[index].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[index].GetStrokeDashArray())
-
-				r.MoveTo(lx, ly)
-				r.LineTo(lx+lineLengthMinimum, ly)
-				r.Stroke()
+				drawLegendSwatch(r, lx, lx+lineLengthMinimum, ly, th2, lines[index])
 
 				tx += textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
 			}
@@ -320,13 +439,13 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 // LegendLeft is a legend that is designed for longer series lists.
 func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
-		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
+		legendDefaults := c.GetTheme().LegendStyle.InheritFrom(Style{
+			FillColor:   c.GetColorPalette().BackgroundColor(),
+			FontColor:   c.GetColorPalette().TextColor(),
 			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
+			StrokeColor: c.GetColorPalette().AxisStrokeColor(),
 			StrokeWidth: DefaultAxisLineWidth,
-		}
+		})
 
 		var legendStyle Style
 		if len(userDefaults) > 0 {
@@ -416,13 +535,7 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 				ly := ty - th2
 				lx2 := legendContent.Right - legendPadding.Right
 
-				r.SetStrokeColor(lines[x].GetStrokeColor())
-				r.SetStrokeWidth(lines[x].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
-
-				r.MoveTo(lx, ly)
-				r.LineTo(lx2, ly)
-				r.Stroke()
+				drawLegendSwatch(r, lx, lx2, ly, th2, lines[x])
 
 				ycursor += tb.Height()
 				legendCount++
@@ -430,3 +543,234 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 	}
 }
+
+// drawLegendSwatch draws the line of a series in a legend, with one of its markers in the middle
+// if the series draws dots, at most `maxDotWidth` wide so it fits the legend row.
+func drawLegendSwatch(r Renderer, x1, x2, y, maxDotWidth int, style Style) {
+	r.SetStrokeColor(style.GetStrokeColor())
+	r.SetStrokeWidth(style.GetStrokeWidth())
+	r.SetStrokeDashArray(style.GetStrokeDashArray())
+
+	r.MoveTo(x1, y)
+	r.LineTo(x2, y)
+	r.Stroke()
+
+	if style.ShouldDrawDot() {
+		dotStyle := style
+		dotStyle.DotWidth = math.Min(style.GetDotWidth(), float64(maxDotWidth))
+		Draw.Dot(r, (x1+x2)>>1, y, dotStyle)
+	}
+}
+
+// styleDefaultsLegend returns the default style of the legend of a chart.
+func styleDefaultsLegend(theme Theme, font *truetype.Font, cp ColorPalette) Style {
+	return theme.LegendStyle.InheritFrom(Style{
+		Font:        font,
+		FontColor:   cp.TextColor(),
+		FontSize:    DefaultLegendFontSize,
+		FillColor:   cp.BackgroundColor(),
+		StrokeColor: cp.AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		Padding:     DefaultLegendPadding,
+	})
+}
+
+// chartLegend is the legend of a chart being rendered.
+type chartLegend struct {
+	options LegendOptions
+	style   Style
+	entries []LegendEntry
+}
+
+// newChartLegend returns the legend of a chart, without entries if the legend isn't shown.
+func newChartLegend(options LegendOptions, provider LegendEntriesProvider, defaults Style) chartLegend {
+	cl := chartLegend{
+		options: options,
+		style:   options.Style.InheritFrom(defaults),
+	}
+	if options.Style.Show {
+		cl.entries = options.GetEntries(provider)
+	}
+	return cl
+}
+
+// adjustCanvasBox returns the canvas box shrunk to make room for a legend outside of it.
+func (cl chartLegend) adjustCanvasBox(r Renderer, canvasBox Box) Box {
+	if len(cl.entries) == 0 || cl.options.Placement.IsInside() {
+		return canvasBox
+	}
+
+	grid := cl.getGrid(r, canvasBox)
+	switch cl.options.Placement {
+	case LegendPlacementTop:
+		canvasBox.Top += grid.Height() + DefaultLegendMargin
+	case LegendPlacementLeft:
+		canvasBox.Left += grid.Width() + DefaultLegendMargin
+	case LegendPlacementRight:
+		canvasBox.Right -= grid.Width() + DefaultLegendMargin
+	default:
+		canvasBox.Bottom -= grid.Height() + DefaultLegendMargin
+	}
+	return canvasBox
+}
+
+// draw draws the legend around the box passed to `adjustCanvasBox`, or inside the final canvas box.
+func (cl chartLegend) draw(r Renderer, box, canvasBox Box) {
+	if len(cl.entries) == 0 {
+		return
+	}
+	defer r.ResetStyle()
+
+	var grid legendGrid
+	if cl.options.Placement.IsInside() {
+		grid = cl.getGrid(r, Box{
+			Top:    canvasBox.Top + DefaultLegendMargin,
+			Left:   canvasBox.Left + DefaultLegendMargin,
+			Right:  canvasBox.Right - DefaultLegendMargin,
+			Bottom: canvasBox.Bottom - DefaultLegendMargin,
+		})
+	} else {
+		grid = cl.getGrid(r, box)
+	}
+	legendBox := cl.getBox(grid, box, canvasBox)
+
+	if cl.style.ShouldDrawFill() || cl.style.ShouldDrawStroke() {
+		Draw.Box(r, legendBox, cl.style)
+	}
+
+	for index, entry := range cl.entries {
+		row, column := index/grid.columns, index%grid.columns
+		x := legendBox.Left + cl.style.Padding.Left + column*DefaultLegendColumnSpacing
+		for _, width := range grid.columnWidths[:column] {
+			x += width
+		}
+		y := legendBox.Top + cl.style.Padding.Top + row*(grid.lineHeight+DefaultLegendRowSpacing)
+
+		drawLegendMarker(r, entry, x, y+(grid.lineHeight>>1), grid.lineHeight)
+
+		cl.style.GetTextOptions().WriteToRenderer(r)
+		r.Text(entry.Label, x+getLegendMarkerWidth(entry, grid.lineHeight)+DefaultLegendMarkerTextGap, y+grid.lineHeight)
+	}
+}
+
+// getGrid wraps the entries into the columns of the options, or as many columns as fit the width of
+// the box for a legend above or below the canvas, or as few as fit the height of the box otherwise.
+func (cl chartLegend) getGrid(r Renderer, box Box) legendGrid {
+	cl.style.GetTextOptions().WriteToRenderer(r)
+	lineHeight := r.MeasureText("Ag").Height()
+	widths := make([]int, len(cl.entries))
+	for index, entry := range cl.entries {
+		widths[index] = getLegendMarkerWidth(entry, lineHeight) + DefaultLegendMarkerTextGap + r.MeasureText(entry.Label).Width()
+	}
+
+	if cl.options.Columns > 0 {
+		return newLegendGrid(widths, util.Math.MinInt(cl.options.Columns, len(widths)), lineHeight, cl.style.Padding)
+	}
+	if cl.options.Placement.IsHorizontal() {
+		for columns := len(widths); columns > 1; columns-- {
+			if grid := newLegendGrid(widths, columns, lineHeight, cl.style.Padding); grid.Width() <= box.Width() {
+				return grid
+			}
+		}
+		return newLegendGrid(widths, 1, lineHeight, cl.style.Padding)
+	}
+	for columns := 1; columns < len(widths); columns++ {
+		if grid := newLegendGrid(widths, columns, lineHeight, cl.style.Padding); grid.Height() <= box.Height() {
+			return grid
+		}
+	}
+	return newLegendGrid(widths, len(widths), lineHeight, cl.style.Padding)
+}
+
+// getBox returns the box of the legend, centered along the side of the box it shrank,
+// or in a corner of the canvas box.
+func (cl chartLegend) getBox(grid legendGrid, box, canvasBox Box) Box {
+	width, height := grid.Width(), grid.Height()
+
+	var top, left int
+	switch cl.options.Placement {
+	case LegendPlacementTop:
+		top, left = box.Top, box.Left+((box.Width()-width)>>1)
+	case LegendPlacementLeft:
+		top, left = box.Top+((box.Height()-height)>>1), box.Left
+	case LegendPlacementRight:
+		top, left = box.Top+((box.Height()-height)>>1), box.Right-width
+	case LegendPlacementInsideTopLeft:
+		top, left = canvasBox.Top+DefaultLegendMargin, canvasBox.Left+DefaultLegendMargin
+	case LegendPlacementInsideTopRight:
+		top, left = canvasBox.Top+DefaultLegendMargin, canvasBox.Right-DefaultLegendMargin-width
+	case LegendPlacementInsideBottomLeft:
+		top, left = canvasBox.Bottom-DefaultLegendMargin-height, canvasBox.Left+DefaultLegendMargin
+	case LegendPlacementInsideBottomRight:
+		top, left = canvasBox.Bottom-DefaultLegendMargin-height, canvasBox.Right-DefaultLegendMargin-width
+	default:
+		top, left = box.Bottom-height, box.Left+((box.Width()-width)>>1)
+	}
+	return Box{Top: top, Left: left, Right: left + width, Bottom: top + height}
+}
+
+// legendGrid is the layout of legend entries wrapped into rows of columns.
+type legendGrid struct {
+	columns      int
+	rows         int
+	columnWidths []int
+	lineHeight   int
+	padding      Box
+}
+
+// newLegendGrid returns the entries of the given widths wrapped into rows of `columns` entries.
+func newLegendGrid(widths []int, columns, lineHeight int, padding Box) legendGrid {
+	grid := legendGrid{
+		columns:      columns,
+		rows:         (len(widths) + columns - 1) / columns,
+		columnWidths: make([]int, columns),
+		lineHeight:   lineHeight,
+		padding:      padding,
+	}
+	for index, width := range widths {
+		grid.columnWidths[index%columns] = util.Math.MaxInt(grid.columnWidths[index%columns], width)
+	}
+	return grid
+}
+
+// Width returns the width of the legend including its padding.
+func (lg legendGrid) Width() int {
+	width := lg.padding.Left + lg.padding.Right + (lg.columns-1)*DefaultLegendColumnSpacing
+	for _, columnWidth := range lg.columnWidths {
+		width += columnWidth
+	}
+	return width
+}
+
+// Height returns the height of the legend including its padding.
+func (lg legendGrid) Height() int {
+	return lg.padding.Top + lg.padding.Bottom + lg.rows*lg.lineHeight + (lg.rows-1)*DefaultLegendRowSpacing
+}
+
+func getLegendMarkerWidth(entry LegendEntry, lineHeight int) int {
+	if entry.Marker == LegendMarkerLine {
+		return DefaultLegendLineLength
+	}
+	return lineHeight
+}
+
+// drawLegendMarker draws the marker of an entry starting at x, vertically centered on y.
+func drawLegendMarker(r Renderer, entry LegendEntry, x, y, lineHeight int) {
+	defer r.ResetStyle()
+
+	half := lineHeight >> 1
+	switch entry.Marker {
+	case LegendMarkerBox:
+		Draw.Box(r, Box{Top: y - half, Left: x, Right: x + lineHeight, Bottom: y + half}, Style{
+			FillColor:   entry.Style.GetFillColor(),
+			StrokeColor: entry.Style.GetStrokeColor(),
+			StrokeWidth: math.Min(entry.Style.GetStrokeWidth(), DefaultAxisLineWidth),
+		})
+	case LegendMarkerDot:
+		dotStyle := entry.Style
+		dotStyle.DotWidth = math.Min(entry.Style.GetDotWidth(float64(half)), float64(half))
+		Draw.Dot(r, x+half, y, dotStyle)
+	default:
+		drawLegendSwatch(r, x, x+DefaultLegendLineLength, y, half, entry.Style)
+	}
+}
@@ -2,6 +2,7 @@ package chart
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/blend/go-sdk/assert"
@@ -29,3 +30,123 @@ func TestLegend(t *testing.T) {
 	assert.Nil(err)
 	assert.NotZero(buf.Len())
 }
+
+func TestLegendOptionsGetEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "a", XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "hidden", Style: Style{StrokeWidth: 2}, XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "dots", Style: Style{Show: true, StrokeWidth: Disabled, DotWidth: 3}, XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+		},
+	}
+
+	entries := LegendOptions{}.GetEntries(&c)
+	assert.Len(2, entries)
+	assert.Equal("a", entries[0].Label)
+	assert.Equal(LegendMarkerLine, entries[0].Marker)
+	assert.Equal("dots", entries[1].Label)
+	assert.Equal(LegendMarkerDot, entries[1].Marker)
+
+	entries = LegendOptions{Entries: []LegendEntry{{Label: "custom"}, {}}}.GetEntries(&c)
+	assert.Len(1, entries)
+	assert.Equal("custom", entries[0].Label)
+}
+
+func TestLegendGrid(t *testing.T) {
+	assert := assert.New(t)
+
+	grid := newLegendGrid([]int{10, 20, 30}, 2, 10, Box{Top: 1, Left: 2, Right: 3, Bottom: 4})
+	assert.Equal(2, grid.rows)
+	assert.Equal([]int{30, 20}, grid.columnWidths)
+	assert.Equal(2+3+30+20+DefaultLegendColumnSpacing, grid.Width())
+	assert.Equal(1+4+2*10+DefaultLegendRowSpacing, grid.Height())
+}
+
+func TestChartLegendAdjustCanvasBox(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(800, 600)
+	assert.Nil(err)
+
+	entries := []LegendEntry{{Label: "first"}, {Label: "second"}, {Label: "third"}}
+	box := Box{Top: 0, Left: 0, Right: 800, Bottom: 600}
+	newLegend := func(placement LegendPlacement) chartLegend {
+		return newChartLegend(LegendOptions{Style: StyleShow(), Placement: placement, Entries: entries}, nil, Style{Font: f, FontSize: DefaultLegendFontSize})
+	}
+
+	bottom := newLegend(LegendPlacementBottom).adjustCanvasBox(r, box)
+	assert.True(bottom.Bottom < box.Bottom)
+	assert.Equal(box.Top, bottom.Top)
+
+	top := newLegend(LegendPlacementTop).adjustCanvasBox(r, box)
+	assert.Equal(box.Bottom-bottom.Bottom, top.Top)
+
+	right := newLegend(LegendPlacementRight).adjustCanvasBox(r, box)
+	assert.True(right.Right < box.Right)
+	left := newLegend(LegendPlacementLeft).adjustCanvasBox(r, box)
+	assert.Equal(box.Right-right.Right, left.Left)
+
+	// a single row below the canvas, a single column beside it.
+	assert.True(box.Right-right.Right < 200)
+	assert.True(box.Bottom-bottom.Bottom < 50)
+
+	assert.Equal(box, newLegend(LegendPlacementInsideTopRight).adjustCanvasBox(r, box))
+	hidden := newChartLegend(LegendOptions{Entries: entries}, nil, Style{Font: f})
+	assert.Equal(box, hidden.adjustCanvasBox(r, box))
+}
+
+func TestLegendOptionsRender(t *testing.T) {
+	assert := assert.New(t)
+
+	placements := []LegendPlacement{
+		LegendPlacementBottom, LegendPlacementTop, LegendPlacementLeft, LegendPlacementRight,
+		LegendPlacementInsideTopLeft, LegendPlacementInsideBottomRight,
+	}
+	for _, placement := range placements {
+		legend := LegendOptions{Style: StyleShow(), Placement: placement}
+		charts := []interface {
+			Render(RendererProvider, io.Writer) error
+		}{
+			Chart{
+				Legend: legend,
+				Series: []Series{ContinuousSeries{Name: "a", XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}}},
+			},
+			BarChart{
+				Legend: legend,
+				Bars:   []Value{{Label: "a", Value: 1}, {Label: "b", Value: 2}},
+			},
+			PieChart{
+				Legend: legend,
+				Values: []Value{{Label: "a", Value: 1}, {Label: "b", Value: 2}},
+			},
+		}
+		for _, c := range charts {
+			buf := bytes.NewBuffer([]byte{})
+			assert.Nil(c.Render(PNG, buf))
+			assert.NotZero(buf.Len())
+		}
+	}
+}
+
+func TestChartTypesGetLegendEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := PieChart{Values: []Value{{Label: "a", Value: 1}, {Label: "zero", Value: 0}, {Label: "b", Value: 3}}}
+	entries := pie.GetLegendEntries()
+	assert.Len(2, entries)
+	assert.Equal("b", entries[1].Label)
+	assert.Equal(LegendMarkerBox, entries[1].Marker)
+
+	bubbles := []BubbleValue{{Value: Value{Label: "small", Value: 1}}, {Value: Value{Label: "large", Value: 5}}}
+	bubble := BubbleChart{Bubbles: bubbles}
+	entries = bubble.GetLegendEntries()
+	assert.Len(2, entries)
+	assert.Equal("large", entries[0].Label)
+	assert.Equal(LegendMarkerDot, entries[0].Marker)
+	assert.Equal("small", bubbles[0].Value.Label, "the bubbles shouldn't be sorted in place")
+}
@@ -0,0 +1,245 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultLogarithmicRangeBase is the default base of a logarithmic range.
+	DefaultLogarithmicRangeBase = 10.0
+	// DefaultLogarithmicRangeDecades is the number of decades below the max a logarithmic range
+	// will span if its min is not positive.
+	DefaultLogarithmicRangeDecades = 6
+)
+
+// LogarithmicRange represents a boundary for a set of numbers that are mapped onto the domain
+// by their logarithm, i.e. each power of the base (a decade) takes up the same amount of space.
+// Values that are not positive cannot be represented and are pinned to the min of the range.
+type LogarithmicRange struct {
+	Base       float64
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// IsVertical spaces the tick labels by their height instead of their width, the axis sets it.
+	IsVertical bool
+}
+
+// IsDescending returns if the range is descending.
+func (r LogarithmicRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the LogarithmicRange has been set or not.
+func (r LogarithmicRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetBase returns the logarithm base for the range.
+func (r LogarithmicRange) GetBase() float64 {
+	if r.Base <= 1 {
+		return DefaultLogarithmicRangeBase
+	}
+	return r.Base
+}
+
+// GetMin gets the min value for the range.
+// If the min is not positive, it is coalesced to `DefaultLogarithmicRangeDecades` decades below the max.
+func (r LogarithmicRange) GetMin() float64 {
+	if r.Min > 0 {
+		return r.Min
+	}
+	if r.Max > 0 {
+		return math.Pow(r.GetBase(), math.Floor(r.log(r.Max))-DefaultLogarithmicRangeDecades)
+	}
+	return 1.0
+}
+
+// SetMin sets the min value for the range.
+func (r *LogarithmicRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax returns the max value for the range.
+// If the max is not positive, it is coalesced to one decade above the min.
+func (r LogarithmicRange) GetMax() float64 {
+	if r.Max > 0 {
+		return r.Max
+	}
+	return r.GetMin() * r.GetBase()
+}
+
+// SetMax sets the max value for the range.
+func (r *LogarithmicRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r LogarithmicRange) GetDelta() float64 {
+	return r.GetMax() - r.GetMin()
+}
+
+// GetDomain returns the range domain.
+func (r LogarithmicRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *LogarithmicRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// SetVertical sets if the range is on a vertical axis.
+func (r *LogarithmicRange) SetVertical(isVertical bool) {
+	r.IsVertical = isVertical
+}
+
+// String returns a simple string for the LogarithmicRange.
+func (r LogarithmicRange) String() string {
+	return fmt.Sprintf("LogarithmicRange (base %.2f) [%.2f,%.2f] => %d", r.GetBase(), r.GetMin(), r.GetMax(), r.Domain)
+}
+
+// Translate maps a given value into the LogarithmicRange space.
+// Values that are not positive are translated as if they were the min.
+func (r LogarithmicRange) Translate(value float64) int {
+	min, max := r.GetMin(), r.GetMax()
+	if value <= 0 {
+		value = min
+	}
+
+	normalized := r.log(value) - r.log(min)
+	ratio := normalized / (r.log(max) - r.log(min))
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// GetTicks returns ticks at the decades of the range, thinned out or filled in with 1-2-5
+// multiples so the labels fit the domain.
+func (r *LogarithmicRange) GetTicks(rr Renderer, defaults Style, vf ValueFormatter) []Tick {
+	return GenerateLogarithmicTicks(rr, r, r.IsVertical, defaults, vf)
+}
+
+// GetGridLines returns major gridlines for each tick and minor gridlines for each
+// integer multiple of a decade in between (i.e. 2, 3 ... 9 for base 10).
+func (r LogarithmicRange) GetGridLines(ticks []Tick, isVertical bool, majorStyle, minorStyle Style) []GridLine {
+	return GenerateGridLinesWithMinorTicks(&r, ticks, r.GetMinorTicks(ticks, 0), majorStyle, minorStyle)
+}
+
+// GetMinorTicks returns the integer multiples of each decade within the range (i.e. 2, 3 ... 9 for base 10),
+// the decade already sets the subdivisions so `subdivisions` is ignored.
+func (r LogarithmicRange) GetMinorTicks(ticks []Tick, subdivisions int) []float64 {
+	var values []float64
+	min, max := r.GetMin(), r.GetMax()
+	base := r.GetBase()
+	for _, decade := range r.decades(min, max, 1) {
+		for m := 2.0; m < base; m++ {
+			if value := decade * m; value >= min && value <= max {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// decades returns every `step`th power of the base that falls within (or just below) [min,max].
+func (r LogarithmicRange) decades(min, max float64, step int) []float64 {
+	var values []float64
+	base := r.GetBase()
+	start := int(math.Floor(r.log(min)))
+	end := int(math.Ceil(r.log(max)))
+	for exponent := start; exponent <= end && len(values) < DefaultTickCountSanityCheck; exponent += step {
+		values = append(values, math.Pow(base, float64(exponent)))
+	}
+	return values
+}
+
+func isLogarithmicRange(ra Range) bool {
+	_, isLogarithmic := ra.(*LogarithmicRange)
+	return isLogarithmic
+}
+
+// log returns the logarithm of the value in the range base, snapping results that are
+// within floating point error of an integer (i.e. exact powers of the base) to that integer.
+func (r LogarithmicRange) log(value float64) float64 {
+	l := math.Log(value) / math.Log(r.GetBase())
+	if rounded := math.Round(l); math.Abs(l-rounded) < 1e-9 {
+		return rounded
+	}
+	return l
+}
+
+// GenerateLogarithmicTicks generates a set of ticks at the powers of the range base (decades).
+// If the labels would overlap, every second (third, etc.) decade is labeled instead,
+// and if the range spans less than two decades the 1-2-5 multiples of each decade are used.
+func GenerateLogarithmicTicks(r Renderer, ra *LogarithmicRange, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+
+	min, max := ra.GetMin(), ra.GetMax()
+	// guard against floating point error in math.Pow putting a decade just outside the range.
+	epsilon := (max - min) * 1e-9
+	inRange := func(values []float64) (output []float64) {
+		for _, v := range values {
+			if v >= min-epsilon && v <= max+epsilon {
+				output = append(output, v)
+			}
+		}
+		return
+	}
+
+	values := inRange(ra.decades(min, max, 1))
+	if len(values) < 2 {
+		var multiples []float64
+		for _, decade := range ra.decades(min, max, 1) {
+			multiples = append(multiples, decade, 2*decade, 5*decade)
+		}
+		values = inRange(multiples)
+	}
+
+	style.GetTextOptions().WriteToRenderer(r)
+	for step := 2; step < DefaultTickCountSanityCheck && len(values) > 1; step++ {
+		if logarithmicTicksFit(r, values, isVertical, ra.GetDomain(), vf) {
+			break
+		}
+		values = inRange(ra.decades(min, max, step))
+	}
+
+	if ra.IsDescending() {
+		sort.Sort(sort.Reverse(sort.Float64Slice(values)))
+	}
+
+	ticks := make([]Tick, len(values))
+	for index, v := range values {
+		ticks[index] = Tick{Value: v, Label: vf(v)}
+	}
+	return ticks
+}
+
+func logarithmicTicksFit(r Renderer, values []float64, isVertical bool, domain int, vf ValueFormatter) bool {
+	var total int
+	for index, v := range values {
+		tb := r.MeasureText(vf(v))
+		if isVertical {
+			total += tb.Height()
+			if index > 0 {
+				total += DefaultMinimumTickVerticalSpacing
+			}
+		} else {
+			total += tb.Width()
+			if index > 0 {
+				total += DefaultMinimumTickHorizontalSpacing
+			}
+		}
+	}
+	return total <= domain
+}
@@ -0,0 +1,151 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestLogarithmicRangeTranslate(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 1, Max: 10000, Domain: 1000}
+	assert.Equal(0, r.Translate(1))
+	assert.Equal(250, r.Translate(10))
+	assert.Equal(500, r.Translate(100))
+	assert.Equal(1000, r.Translate(10000))
+
+	r.Descending = true
+	assert.Equal(1000, r.Translate(1))
+	assert.Equal(750, r.Translate(10))
+}
+
+func TestLogarithmicRangeBase(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 1, Max: 1024, Domain: 100}
+	assert.Equal(DefaultLogarithmicRangeBase, r.GetBase())
+
+	r.Base = 2
+	assert.Equal(2.0, r.GetBase())
+	assert.Equal(50, r.Translate(32))
+}
+
+func TestLogarithmicRangeNonPositive(t *testing.T) {
+	assert := assert.New(t)
+
+	r := LogarithmicRange{Min: 0, Max: 1000, Domain: 900}
+	assert.InDelta(0.001, r.GetMin(), 0.0000001)
+	assert.Equal(0, r.Translate(0))
+	assert.Equal(0, r.Translate(-50))
+	assert.Equal(900, r.Translate(1000))
+
+	r = LogarithmicRange{}
+	assert.True(r.IsZero())
+	assert.Equal(1.0, r.GetMin())
+	assert.Equal(10.0, r.GetMax())
+}
+
+func TestGenerateLogarithmicTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	r.SetFont(f)
+
+	ra := &LogarithmicRange{Min: 1, Max: 100000, Domain: 1024}
+	ticks := GenerateLogarithmicTicks(r, ra, true, Style{Font: f}, nil)
+	assert.Len(6, ticks)
+	assert.Equal(1.0, ticks[0].Value)
+	assert.InDelta(100000.0, ticks[5].Value, 0.0001)
+
+	ra.Domain = 64
+	ticks = GenerateLogarithmicTicks(r, ra, true, Style{Font: f}, nil)
+	assert.True(len(ticks) < 6)
+
+	ra = &LogarithmicRange{Min: 3, Max: 30, Domain: 1024}
+	ticks = GenerateLogarithmicTicks(r, ra, true, Style{Font: f}, nil)
+	assert.Len(3, ticks)
+	assert.Equal(5.0, ticks[0].Value)
+	assert.Equal(10.0, ticks[1].Value)
+	assert.Equal(20.0, ticks[2].Value)
+}
+
+func TestLogarithmicRangeGridLines(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := LogarithmicRange{Min: 1, Max: 100}
+	ticks := []Tick{{Value: 1}, {Value: 10}, {Value: 100}}
+	gl := ra.GetGridLines(ticks, false, Style{}, Style{})
+	assert.Len(17, gl)
+
+	var majors int
+	for _, l := range gl {
+		if l.Major() {
+			majors++
+		}
+	}
+	assert.Equal(1, majors)
+}
+
+func TestLogarithmicRangeMinorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &LogarithmicRange{Min: 1, Max: 100}
+	ya := YAxis{MinorSubdivisions: 5}
+	minor := ya.GetMinorTicks(ra, []Tick{{Value: 1}, {Value: 10}, {Value: 100}})
+	assert.Len(16, minor)
+	assert.Equal(2.0, minor[0])
+	assert.Equal(90.0, minor[len(minor)-1])
+}
+
+func TestLogarithmicRangeTicksOrientation(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: DefaultFontSize}
+	ra := &LogarithmicRange{Min: 1, Max: 1000000, Domain: 400}
+	yticks := YAxis{}.GetTicks(r, ra, style, nil)
+	assert.True(ra.IsVertical)
+	assert.Len(7, yticks)
+
+	xticks := XAxis{}.GetTicks(r, ra, style, nil)
+	assert.False(ra.IsVertical)
+	assert.True(len(xticks) < len(yticks))
+}
+
+func TestLogarithmicRangeMinorGridLinesFollowMinorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &LogarithmicRange{Min: 1, Max: 100}
+	ticks := []Tick{{Value: 1}, {Value: 10}, {Value: 100}}
+
+	countMinor := func(gridLines []GridLine) (minors int) {
+		for _, gl := range gridLines {
+			if gl.IsMinor {
+				minors++
+			}
+		}
+		return
+	}
+
+	ya := YAxis{GridMajorStyle: StyleShow(), GridMinorStyle: StyleShow()}
+	assert.Empty(ya.GetMinorTicks(ra, ticks))
+	assert.Zero(countMinor(ya.getGridLines(ra, ticks)))
+	assert.Len(1, ya.getGridLines(ra, ticks))
+
+	ya.MinorSubdivisions = 5
+	assert.Len(16, ya.GetMinorTicks(ra, ticks))
+	assert.Equal(16, countMinor(ya.getGridLines(ra, ticks)))
+
+	xa := XAxis{GridMajorStyle: StyleShow(), GridMinorStyle: StyleShow()}
+	assert.Empty(xa.GetMinorTicks(ra, ticks))
+	assert.Zero(countMinor(xa.getGridLines(ra, ticks)))
+}
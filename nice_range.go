@@ -0,0 +1,297 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	util "github.com/daill/go-chart/util"
+)
+
+const (
+	// DefaultNiceTickCount is the default maximum number of nice ticks.
+	DefaultNiceTickCount = 10
+	// DefaultNiceTickSpacing is the space an expanded nice range leaves for each tick when it picks its step.
+	DefaultNiceTickSpacing = 50
+)
+
+var (
+	// NiceStepMultipliers are the multiples of a power of ten nice tick steps are chosen from.
+	NiceStepMultipliers = []float64{1, 2, 2.5, 5, 10}
+)
+
+// NiceRange is a continuous range whose ticks fall on "nice" numbers, i.e. 1, 2, 2.5 or 5 times
+// a power of ten, instead of splitting the range evenly between its raw min and max.
+// Use it as the `Range` of an `XAxis` or `YAxis` to switch the tick strategy of the axis.
+type NiceRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// TickCount is the maximum number of ticks, fewer are used if the labels wouldn't fit.
+	TickCount int
+
+	// Expand extends the range outwards to the nice ticks enclosing min and max,
+	// using as many ticks as the domain has room for at `DefaultNiceTickSpacing`.
+	Expand bool
+
+	// IsVertical spaces the tick labels by their height instead of their width, the axis sets it.
+	IsVertical bool
+}
+
+// GetTickCount returns the maximum number of ticks or a default.
+func (r NiceRange) GetTickCount() int {
+	if r.TickCount < 2 {
+		return DefaultNiceTickCount
+	}
+	return r.TickCount
+}
+
+// getExpandedTickCount returns the tick count the expanded bounds are picked for,
+// as many as the domain has room for up to the maximum.
+func (r NiceRange) getExpandedTickCount() int {
+	if r.Domain <= 0 {
+		return r.GetTickCount()
+	}
+	return util.Math.MinInt(r.GetTickCount(), util.Math.MaxInt(2, r.Domain/DefaultNiceTickSpacing+1))
+}
+
+// IsDescending returns if the range is descending.
+func (r NiceRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the NiceRange has been set or not.
+func (r NiceRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetMin gets the min value for the range, expanded to a nice number if set to.
+func (r NiceRange) GetMin() float64 {
+	if r.Expand {
+		min, _ := NiceBounds(r.Min, r.Max, r.getExpandedTickCount())
+		return min
+	}
+	return r.Min
+}
+
+// SetMin sets the min value for the range.
+func (r *NiceRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax gets the max value for the range, expanded to a nice number if set to.
+func (r NiceRange) GetMax() float64 {
+	if r.Expand {
+		_, max := NiceBounds(r.Min, r.Max, r.getExpandedTickCount())
+		return max
+	}
+	return r.Max
+}
+
+// SetMax sets the max value for the range.
+func (r *NiceRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r NiceRange) GetDelta() float64 {
+	return r.GetMax() - r.GetMin()
+}
+
+// GetDomain returns the range domain.
+func (r NiceRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *NiceRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// SetVertical sets if the range is on a vertical axis.
+func (r *NiceRange) SetVertical(isVertical bool) {
+	r.IsVertical = isVertical
+}
+
+// String returns a simple string for the NiceRange.
+func (r NiceRange) String() string {
+	return fmt.Sprintf("NiceRange [%.2f,%.2f] => %d", r.GetMin(), r.GetMax(), r.Domain)
+}
+
+// Translate maps a given value into the NiceRange space.
+func (r NiceRange) Translate(value float64) int {
+	normalized := value - r.GetMin()
+	ratio := normalized / r.GetDelta()
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// GetTicks returns ticks on multiples of a nice step, as many as fit the domain up to the tick count.
+// An expanded range ends on its first and last tick; if the labels don't fit, every second (fifth, etc.)
+// tick is kept, skipping a number of ticks that still ends on the last one.
+func (r *NiceRange) GetTicks(rr Renderer, defaults Style, vf ValueFormatter) []Tick {
+	if !r.Expand {
+		return GenerateNiceTicks(rr, r, r.IsVertical, defaults, vf, r.GetTickCount())
+	}
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+
+	defaults.GetTextOptions().WriteToRenderer(rr)
+	min, max, step := niceBounds(r.Min, r.Max, r.getExpandedTickCount())
+	all := niceValues(min, max, step)
+	intervals := len(all) - 1
+	var values []float64
+	for every := 1; every <= util.Math.MaxInt(1, intervals); every++ {
+		if intervals > 0 && intervals%every != 0 {
+			continue
+		}
+		values = values[:0]
+		for index := 0; index < len(all); index += every {
+			values = append(values, all[index])
+		}
+		if tickSize := niceTickSize(rr, values, r.IsVertical, vf); r.Domain == 0 || (len(values)-1)*tickSize <= r.Domain {
+			break
+		}
+	}
+
+	ticks := make([]Tick, len(values))
+	for index, value := range values {
+		if r.IsDescending() {
+			value = values[len(values)-1-index]
+		}
+		ticks[index] = Tick{Value: value, Label: vf(value)}
+	}
+	return ticks
+}
+
+func isNiceRange(ra Range) bool {
+	_, isNice := ra.(*NiceRange)
+	return isNice
+}
+
+// NiceStep returns the smallest nice step that splits a span into at most `count` ticks.
+func NiceStep(span float64, count int) float64 {
+	span = math.Abs(span)
+	if span == 0 || math.IsNaN(span) || math.IsInf(span, 0) {
+		return 0
+	}
+	if count < 2 {
+		count = 2
+	}
+
+	raw := span / float64(count-1)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, multiplier := range NiceStepMultipliers {
+		if step := multiplier * magnitude; step >= raw*(1-1e-9) {
+			return step
+		}
+	}
+	return 10 * magnitude
+}
+
+// NiceBounds returns the nice numbers enclosing min and max for at most `count` ticks.
+func NiceBounds(min, max float64, count int) (niceMin, niceMax float64) {
+	niceMin, niceMax, _ = niceBounds(min, max, count)
+	return
+}
+
+// niceBounds returns the nice numbers enclosing min and max and the step of the ticks between them.
+func niceBounds(min, max float64, count int) (niceMin, niceMax, step float64) {
+	step = NiceStep(max-min, count)
+	if step == 0 {
+		return min, max, 0
+	}
+	niceMin = roundToStep(math.Floor(min/step+1e-9)*step, step)
+	niceMax = roundToStep(math.Ceil(max/step-1e-9)*step, step)
+
+	// expanding can push the tick count over the limit, so widen the step until it fits.
+	for attempt := 0; attempt < len(NiceStepMultipliers) && (niceMax-niceMin)/step > float64(count-1)+1e-9; attempt++ {
+		step = NiceStep(niceMax-niceMin, count)
+		niceMin = roundToStep(math.Floor(min/step+1e-9)*step, step)
+		niceMax = roundToStep(math.Ceil(max/step-1e-9)*step, step)
+	}
+	return
+}
+
+// NiceTickValues returns the multiples of a nice step within min and max, for at most `count` ticks.
+func NiceTickValues(min, max float64, count int) []float64 {
+	if min > max {
+		min, max = max, min
+	}
+	return niceValues(min, max, NiceStep(max-min, count))
+}
+
+// niceValues returns the multiples of step within min and max.
+func niceValues(min, max, step float64) []float64 {
+	if step == 0 {
+		return []float64{min}
+	}
+
+	var values []float64
+	first := math.Ceil(min/step - 1e-9)
+	last := math.Floor(max/step + 1e-9)
+	for index := first; index <= last && len(values) < DefaultTickCountSanityCheck; index++ {
+		values = append(values, roundToStep(index*step, step))
+	}
+	return values
+}
+
+// roundToStep removes floating point noise from a multiple of a step, i.e. 0.30000000000000004 => 0.3.
+func roundToStep(value, step float64) float64 {
+	decimals := math.Max(0, -math.Floor(math.Log10(step))+2)
+	precision := math.Pow(10, decimals)
+	if rounded := math.Round(value*precision) / precision; rounded != 0 {
+		return rounded
+	}
+	return 0 // avoids labelling -0.
+}
+
+// GenerateNiceTicks generates ticks on nice numbers, using at most `count` ticks and
+// fewer if the labels would be closer than the minimum tick spacing.
+func GenerateNiceTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter, count int) []Tick {
+	if vf == nil {
+		vf = FloatValueFormatter
+	}
+	min, max := ra.GetMin(), ra.GetMax()
+
+	style.GetTextOptions().WriteToRenderer(r)
+	if tickSize := niceTickSize(r, NiceTickValues(min, max, count), isVertical, vf); tickSize > 0 && ra.GetDomain() > 0 {
+		count = util.Math.MinInt(count, ra.GetDomain()/tickSize+1)
+	}
+
+	values := NiceTickValues(min, max, count)
+	if len(values) == 0 {
+		// the step is wider than the range, so no nice number falls in it; label the bounds instead.
+		values = []float64{min, max}
+	}
+	ticks := make([]Tick, len(values))
+	for index, value := range values {
+		if ra.IsDescending() {
+			value = values[len(values)-1-index]
+		}
+		ticks[index] = Tick{Value: value, Label: vf(value)}
+	}
+	return ticks
+}
+
+// niceTickSize returns the space the largest label of the values needs along the axis.
+func niceTickSize(r Renderer, values []float64, isVertical bool, vf ValueFormatter) int {
+	var tickSize int
+	for _, value := range values {
+		labelBox := r.MeasureText(vf(value))
+		if isVertical {
+			tickSize = util.Math.MaxInt(tickSize, labelBox.Height()+DefaultMinimumTickVerticalSpacing)
+		} else {
+			tickSize = util.Math.MaxInt(tickSize, labelBox.Width()+DefaultMinimumTickHorizontalSpacing)
+		}
+	}
+	return tickSize
+}
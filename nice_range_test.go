@@ -0,0 +1,178 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestNiceStep(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(20.0, NiceStep(100, 6))
+	assert.Equal(2.5, NiceStep(10, 5))
+	assert.Equal(0.1, NiceStep(1, 11))
+	assert.Equal(5000.0, NiceStep(-30000, 8))
+	assert.Equal(0.0, NiceStep(0, 10))
+}
+
+func TestNiceTickValues(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]float64{20, 30, 40, 50, 60, 70, 80, 90}, NiceTickValues(13.7, 92.1, 10))
+	assert.Equal([]float64{0, 0.1, 0.2, 0.3}, NiceTickValues(0, 0.3, 4))
+	assert.Equal([]float64{-10, -5, 0, 5, 10}, NiceTickValues(10, -10, 5))
+	assert.Equal([]float64{3}, NiceTickValues(3, 3, 5))
+	assert.False(math.Signbit(NiceTickValues(0, 1, 5)[0]))
+}
+
+func TestNiceBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	min, max := NiceBounds(13.7, 92.1, 10)
+	assert.Equal(10.0, min)
+	assert.Equal(100.0, max)
+
+	min, max = NiceBounds(0.12, 0.97, 5)
+	assert.Equal(0.0, min)
+	assert.Equal(1.0, max)
+	assert.True(len(NiceTickValues(min, max, 5)) <= 5)
+}
+
+func TestNiceRange(t *testing.T) {
+	assert := assert.New(t)
+
+	r := &NiceRange{Min: 13.7, Max: 92.1, Domain: 900}
+	assert.Equal(13.7, r.GetMin())
+	assert.Equal(DefaultNiceTickCount, r.GetTickCount())
+
+	r.Expand = true
+	assert.Equal(10.0, r.GetMin())
+	assert.Equal(100.0, r.GetMax())
+	assert.Equal(0, r.Translate(10))
+	assert.Equal(450, r.Translate(55))
+	assert.Equal(900, r.Translate(100))
+
+	r.Descending = true
+	assert.Equal(900, r.Translate(10))
+
+	// a smaller domain has room for fewer ticks, so the step and the bounds are coarser.
+	r.SetDomain(90)
+	assert.Equal(0.0, r.GetMin())
+	assert.Equal(100.0, r.GetMax())
+}
+
+func TestNiceRangeGetTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	nr := &NiceRange{Min: 13.7, Max: 92.1, Domain: 1000, TickCount: 5}
+	ticks := nr.GetTicks(r, Style{Font: f, FontSize: 10}, FloatValueFormatter)
+	assert.Len(4, ticks)
+	assert.Equal(20.0, ticks[0].Value)
+	assert.Equal("80.00", ticks[3].Label)
+
+	// a narrow domain falls back to fewer ticks so the labels don't overlap.
+	nr.Domain = 100
+	nr.TickCount = 0
+	ticks = nr.GetTicks(r, Style{Font: f, FontSize: 10}, FloatValueFormatter)
+	assert.True(len(ticks) < 4)
+	assert.NotEmpty(ticks)
+}
+
+func TestChartNiceRange(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow(), Range: &NiceRange{Expand: true, TickCount: 6}},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{13.7, 42, 92.1}},
+		},
+	}
+
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	assert.Nil(err)
+	c.defaultFont, err = GetDefaultFont()
+	assert.Nil(err)
+
+	_, _, yr, _, _, yt, _, err := c.layout(r)
+	assert.Nil(err)
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(100.0, yr.GetMax())
+	assert.Equal(0.0, yt[0].Value)
+	assert.Equal(100.0, yt[len(yt)-1].Value)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+}
+
+func TestNiceRangeExpandedTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: DefaultFontSize}
+	for _, domain := range []int{400, 150} {
+		for _, isVertical := range []bool{false, true} {
+			nr := &NiceRange{Min: 3, Max: 87, Expand: true, Domain: domain, IsVertical: isVertical}
+			ticks := nr.GetTicks(r, style, nil)
+			assert.True(len(ticks) > 2)
+			assert.Equal(nr.GetMin(), ticks[0].Value)
+			assert.Equal(nr.GetMax(), ticks[len(ticks)-1].Value)
+		}
+	}
+
+	nr := &NiceRange{Min: 3, Max: 87, Expand: true, Domain: 150}
+	ticks := nr.GetTicks(r, style, nil)
+	assert.Equal([]float64{0, 50, 100}, []float64{ticks[0].Value, ticks[1].Value, ticks[2].Value})
+}
+
+func TestNiceRangeBoundsBeforeTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: DefaultFontSize}
+	for _, domain := range []int{60, 150, 400, 1000} {
+		for _, isVertical := range []bool{false, true} {
+			nr := &NiceRange{Min: 3, Max: 87654, Expand: true, Domain: domain, IsVertical: isVertical}
+			min, max := nr.GetMin(), nr.GetMax()
+			ticks := nr.GetTicks(r, style, nil)
+			assert.Equal(min, nr.GetMin())
+			assert.Equal(max, nr.GetMax())
+			assert.Equal(min, ticks[0].Value)
+			assert.Equal(max, ticks[len(ticks)-1].Value)
+		}
+	}
+}
+
+func TestNiceRangeTicksOrientation(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: DefaultFontSize}
+	nr := &NiceRange{Min: 0, Max: 1000000, Domain: 400}
+	yticks := YAxis{}.GetTicks(r, nr, style, nil)
+	assert.True(nr.IsVertical)
+	xticks := XAxis{}.GetTicks(r, nr, style, nil)
+	assert.False(nr.IsVertical)
+	assert.True(len(yticks) > len(xticks))
+	assert.True(len(yticks) > 3)
+}
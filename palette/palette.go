@@ -0,0 +1,131 @@
+// Package palette provides well-known color schemes as color palettes for the series of a chart,
+// and as color providers for values, i.e. the cells of a heatmap.
+package palette
+
+import (
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/drawing"
+)
+
+// Scheme is a list of colors. Categorical schemes tell series apart, sequential schemes go from
+// light to dark and diverging schemes go from one hue through a light middle to another.
+type Scheme []drawing.Color
+
+// Len returns the number of colors in the scheme.
+func (s Scheme) Len() int {
+	return len(s)
+}
+
+// GetColor returns a color of the scheme by index, the index wraps around.
+func (s Scheme) GetColor(index int) drawing.Color {
+	return s[index%len(s)]
+}
+
+// Reverse returns a copy of the scheme in reverse order, i.e. sequential from dark to light.
+func (s Scheme) Reverse() Scheme {
+	reversed := make(Scheme, len(s))
+	for index, c := range s {
+		reversed[len(s)-1-index] = c
+	}
+	return reversed
+}
+
+// ColorPalette returns a color palette with the colors of the scheme as series colors.
+func (s Scheme) ColorPalette() ColorPalette {
+	return ColorPalette{Scheme: s}
+}
+
+// ColorProvider returns a color provider that maps values between vmin and vmax onto the scheme,
+// blending between neighbouring colors. It's meant for sequential and diverging schemes.
+func (s Scheme) ColorProvider() chart.ColorProvider {
+	return func(v, vmin, vmax float64) drawing.Color {
+		return s.Interpolate(normalize(v, vmin, vmax))
+	}
+}
+
+// Interpolate returns the color at t, from 0 at the first color to 1 at the last,
+// blending between neighbouring colors.
+func (s Scheme) Interpolate(t float64) drawing.Color {
+	if len(s) == 1 || t <= 0 {
+		return s[0]
+	}
+	if t >= 1 {
+		return s[len(s)-1]
+	}
+
+	position := t * float64(len(s)-1)
+	index := int(position)
+	return blend(s[index], s[index+1], position-float64(index))
+}
+
+// ColorPalette is a chart color palette with the series colors of a scheme. The background, canvas,
+// axis and text colors come from a base palette, so a scheme can be used with any theme.
+type ColorPalette struct {
+	Scheme Scheme
+	// Base is the palette of the colors other than the series colors, it's `chart.DefaultColorPalette` if nil.
+	Base chart.ColorPalette
+}
+
+// GetBase returns the base palette or the default palette.
+func (cp ColorPalette) GetBase() chart.ColorPalette {
+	if cp.Base != nil {
+		return cp.Base
+	}
+	return chart.DefaultColorPalette
+}
+
+// BackgroundColor returns the background color of the base palette.
+func (cp ColorPalette) BackgroundColor() drawing.Color {
+	return cp.GetBase().BackgroundColor()
+}
+
+// BackgroundStrokeColor returns the background stroke color of the base palette.
+func (cp ColorPalette) BackgroundStrokeColor() drawing.Color {
+	return cp.GetBase().BackgroundStrokeColor()
+}
+
+// CanvasColor returns the canvas color of the base palette.
+func (cp ColorPalette) CanvasColor() drawing.Color {
+	return cp.GetBase().CanvasColor()
+}
+
+// CanvasStrokeColor returns the canvas stroke color of the base palette.
+func (cp ColorPalette) CanvasStrokeColor() drawing.Color {
+	return cp.GetBase().CanvasStrokeColor()
+}
+
+// AxisStrokeColor returns the axis stroke color of the base palette.
+func (cp ColorPalette) AxisStrokeColor() drawing.Color {
+	return cp.GetBase().AxisStrokeColor()
+}
+
+// TextColor returns the text color of the base palette.
+func (cp ColorPalette) TextColor() drawing.Color {
+	return cp.GetBase().TextColor()
+}
+
+// GetSeriesColor returns a color of the scheme by index, the index wraps around.
+func (cp ColorPalette) GetSeriesColor(index int) drawing.Color {
+	return cp.Scheme.GetColor(index)
+}
+
+// normalize returns where v is between vmin and vmax, from 0 to 1.
+func normalize(v, vmin, vmax float64) float64 {
+	if vmax <= vmin {
+		return 0
+	}
+	return (v - vmin) / (vmax - vmin)
+}
+
+// blend returns the color t of the way from a to b.
+func blend(a, b drawing.Color, t float64) drawing.Color {
+	channel := func(from, to uint8) uint8 {
+		return uint8(float64(from) + (float64(to)-float64(from))*t + 0.5)
+	}
+	return drawing.Color{
+		R: channel(a.R, b.R),
+		G: channel(a.G, b.G),
+		B: channel(a.B, b.B),
+		A: channel(a.A, b.A),
+	}
+}
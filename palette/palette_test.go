@@ -0,0 +1,99 @@
+package palette
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/drawing"
+)
+
+func TestSchemeLengths(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Len(10, Tableau10)
+	assert.Len(9, Set1)
+	assert.Len(8, Set2)
+	assert.Len(8, Dark2)
+	assert.Len(8, OkabeIto)
+	for _, scheme := range []Scheme{Blues, Greens, Greys, Oranges, Purples, Reds, YlGnBu, YlOrRd} {
+		assert.Len(9, scheme)
+	}
+	for _, scheme := range []Scheme{RdBu, RdYlBu, BrBG, PiYG, PuOr, Spectral} {
+		assert.Len(11, scheme)
+	}
+
+	assert.Equal(drawing.Color{R: 0x4e, G: 0x79, B: 0xa7, A: 255}, Tableau10[0])
+	assert.Equal(drawing.ColorBlack, OkabeIto[7])
+}
+
+func TestSchemeReverse(t *testing.T) {
+	assert := assert.New(t)
+
+	reversed := Greys.Reverse()
+	assert.Equal(drawing.ColorBlack, reversed[0])
+	assert.Equal(drawing.ColorWhite, reversed[8])
+	assert.Equal(drawing.ColorWhite, Greys[0])
+}
+
+func TestColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	cp := OkabeIto.ColorPalette()
+	seen := map[drawing.Color]bool{}
+	for index := 0; index < 8; index++ {
+		seen[cp.GetSeriesColor(index)] = true
+	}
+	assert.Len(8, seen)
+	assert.Equal(cp.GetSeriesColor(0), cp.GetSeriesColor(8))
+
+	assert.Equal(chart.DefaultColorPalette.BackgroundColor(), cp.BackgroundColor())
+	assert.Equal(chart.DefaultColorPalette.TextColor(), cp.TextColor())
+
+	cp.Base = chart.ThemeDark.ColorPalette
+	assert.Equal(chart.ThemeDark.ColorPalette.BackgroundColor(), cp.BackgroundColor())
+	assert.Equal(chart.ThemeDark.ColorPalette.AxisStrokeColor(), cp.AxisStrokeColor())
+	assert.Equal(OkabeIto[1], cp.GetSeriesColor(1))
+}
+
+func TestColorProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(drawing.ColorWhite, GreysColorProvider(0, 0, 10))
+	assert.Equal(drawing.ColorBlack, GreysColorProvider(10, 0, 10))
+	assert.Equal(Greys[4], GreysColorProvider(5, 0, 10))
+	assert.Equal(drawing.ColorWhite, GreysColorProvider(-5, 0, 10))
+	assert.Equal(drawing.ColorBlack, GreysColorProvider(15, 0, 10))
+	assert.Equal(drawing.ColorWhite, GreysColorProvider(5, 5, 5))
+
+	// halfway between the first two colors.
+	between := Greys.Interpolate(0.0625)
+	assert.Equal(drawing.Color{R: 248, G: 248, B: 248, A: 255}, between)
+
+	assert.Equal(RdBu[5], RdBuColorProvider(0, -1, 1))
+}
+
+func TestColorPaletteRender(t *testing.T) {
+	assert := assert.New(t)
+
+	var series []chart.Series
+	for index := 0; index < 8; index++ {
+		series = append(series, chart.ContinuousSeries{
+			XValues: []float64{1, 2, 3},
+			YValues: []float64{float64(index), float64(index + 1), float64(index)},
+		})
+	}
+	c := chart.Chart{ColorPalette: Tableau10.ColorPalette(), Series: series}
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(chart.PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	hc := chart.HeatmapChart{
+		ColorProvider: YlOrRdColorProvider,
+		Values:        [][]float64{{1, 2}, {3, 4}},
+	}
+	buffer.Reset()
+	assert.Nil(hc.Render(chart.PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
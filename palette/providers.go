@@ -0,0 +1,21 @@
+package palette
+
+// Color providers of the sequential and diverging schemes, to use like `chart.Jet` and
+// `chart.Viridis`, i.e. as the color provider of a heatmap.
+var (
+	BluesColorProvider   = Blues.ColorProvider()
+	GreensColorProvider  = Greens.ColorProvider()
+	GreysColorProvider   = Greys.ColorProvider()
+	OrangesColorProvider = Oranges.ColorProvider()
+	PurplesColorProvider = Purples.ColorProvider()
+	RedsColorProvider    = Reds.ColorProvider()
+	YlGnBuColorProvider  = YlGnBu.ColorProvider()
+	YlOrRdColorProvider  = YlOrRd.ColorProvider()
+
+	RdBuColorProvider     = RdBu.ColorProvider()
+	RdYlBuColorProvider   = RdYlBu.ColorProvider()
+	BrBGColorProvider     = BrBG.ColorProvider()
+	PiYGColorProvider     = PiYG.ColorProvider()
+	PuOrColorProvider     = PuOr.ColorProvider()
+	SpectralColorProvider = Spectral.ColorProvider()
+)
@@ -0,0 +1,61 @@
+package palette
+
+import "github.com/daill/go-chart/drawing"
+
+// Categorical schemes, to tell series apart.
+var (
+	// Tableau10 is the default categorical scheme of Tableau.
+	Tableau10 = fromHex("4e79a7", "f28e2b", "e15759", "76b7b2", "59a14f", "edc948", "b07aa1", "ff9da7", "9c755f", "bab0ac")
+	// Set1 is the ColorBrewer Set1 scheme of strong colors.
+	Set1 = fromHex("e41a1c", "377eb8", "4daf4a", "984ea3", "ff7f00", "ffff33", "a65628", "f781bf", "999999")
+	// Set2 is the ColorBrewer Set2 scheme of soft colors, it's colorblind safe for the first three colors.
+	Set2 = fromHex("66c2a5", "fc8d62", "8da0cb", "e78ac3", "a6d854", "ffd92f", "e5c494", "b3b3b3")
+	// Dark2 is the ColorBrewer Dark2 scheme of dark colors, it's colorblind safe for the first three colors.
+	Dark2 = fromHex("1b9e77", "d95f02", "7570b3", "e7298a", "66a61e", "e6ab02", "a6761d", "666666")
+	// OkabeIto is the scheme of Okabe and Ito, which stays distinguishable with all common kinds of colorblindness.
+	OkabeIto = fromHex("e69f00", "56b4e9", "009e73", "f0e442", "0072b2", "d55e00", "cc79a7", "000000")
+)
+
+// Sequential schemes from ColorBrewer, from light to dark.
+var (
+	// Blues is the ColorBrewer Blues scheme.
+	Blues = fromHex("f7fbff", "deebf7", "c6dbef", "9ecae1", "6baed6", "4292c6", "2171b5", "08519c", "08306b")
+	// Greens is the ColorBrewer Greens scheme.
+	Greens = fromHex("f7fcf5", "e5f5e0", "c7e9c0", "a1d99b", "74c476", "41ab5d", "238b45", "006d2c", "00441b")
+	// Greys is the ColorBrewer Greys scheme.
+	Greys = fromHex("ffffff", "f0f0f0", "d9d9d9", "bdbdbd", "969696", "737373", "525252", "252525", "000000")
+	// Oranges is the ColorBrewer Oranges scheme.
+	Oranges = fromHex("fff5eb", "fee6ce", "fdd0a2", "fdae6b", "fd8d3c", "f16913", "d94801", "a63603", "7f2704")
+	// Purples is the ColorBrewer Purples scheme.
+	Purples = fromHex("fcfbfd", "efedf5", "dadaeb", "bcbddc", "9e9ac8", "807dba", "6a51a3", "54278f", "3f007d")
+	// Reds is the ColorBrewer Reds scheme.
+	Reds = fromHex("fff5f0", "fee0d2", "fcbba1", "fc9272", "fb6a4a", "ef3b2c", "cb181d", "a50f15", "67000d")
+	// YlGnBu is the ColorBrewer yellow, green and blue scheme.
+	YlGnBu = fromHex("ffffd9", "edf8b1", "c7e9b4", "7fcdbb", "41b6c4", "1d91c0", "225ea8", "253494", "081d58")
+	// YlOrRd is the ColorBrewer yellow, orange and red scheme.
+	YlOrRd = fromHex("ffffcc", "ffeda0", "fed976", "feb24c", "fd8d3c", "fc4e2a", "e31a1c", "bd0026", "800026")
+)
+
+// Diverging schemes from ColorBrewer, through a light middle color.
+var (
+	// RdBu is the ColorBrewer red to blue scheme, it's colorblind safe.
+	RdBu = fromHex("67001f", "b2182b", "d6604d", "f4a582", "fddbc7", "f7f7f7", "d1e5f0", "92c5de", "4393c3", "2166ac", "053061")
+	// RdYlBu is the ColorBrewer red, yellow and blue scheme, it's colorblind safe.
+	RdYlBu = fromHex("a50026", "d73027", "f46d43", "fdae61", "fee090", "ffffbf", "e0f3f8", "abd9e9", "74add1", "4575b4", "313695")
+	// BrBG is the ColorBrewer brown to blue green scheme, it's colorblind safe.
+	BrBG = fromHex("543005", "8c510a", "bf812d", "dfc27d", "f6e8c3", "f5f5f5", "c7eae5", "80cdc1", "35978f", "01665e", "003c30")
+	// PiYG is the ColorBrewer pink to yellow green scheme, it's colorblind safe.
+	PiYG = fromHex("8e0152", "c51b7d", "de77ae", "f1b6da", "fde0ef", "f7f7f7", "e6f5d0", "b8e186", "7fbc41", "4d9221", "276419")
+	// PuOr is the ColorBrewer orange to purple scheme, it's colorblind safe.
+	PuOr = fromHex("7f3b08", "b35806", "e08214", "fdb863", "fee0b6", "f7f7f7", "d8daeb", "b2abd2", "8073ac", "542788", "2d004b")
+	// Spectral is the ColorBrewer red, yellow and blue scheme through green.
+	Spectral = fromHex("9e0142", "d53e4f", "f46d43", "fdae61", "fee08b", "ffffbf", "e6f598", "abdda4", "66c2a5", "3288bd", "5e4fa2")
+)
+
+func fromHex(codes ...string) Scheme {
+	scheme := make(Scheme, len(codes))
+	for index, code := range codes {
+		scheme[index] = drawing.ColorFromHex(code)
+	}
+	return scheme
+}
@@ -0,0 +1,649 @@
+package chart
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/daill/go-chart/drawing"
+	"github.com/daill/go-chart/util"
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// pdfGlyphUnitsPerEm is the size of the glyph space of pdf fonts, widths and kerning are given in it.
+	pdfGlyphUnitsPerEm = 1000
+)
+
+// pdfFontTables are the tags of the tables of a truetype font program in the order of the table directory,
+// and the fields of `truetype.Font` that hold them.
+var pdfFontTables = [][2]string{
+	{"OS/2", "os2"},
+	{"cmap", "cmap"},
+	{"cvt ", "cvt"},
+	{"fpgm", "fpgm"},
+	{"glyf", "glyf"},
+	{"hdmx", "hdmx"},
+	{"head", "head"},
+	{"hhea", "hhea"},
+	{"hmtx", "hmtx"},
+	{"kern", "kern"},
+	{"loca", "loca"},
+	{"maxp", "maxp"},
+	{"name", "name"},
+	{"prep", "prep"},
+	{"vmtx", "vmtx"},
+}
+
+// PDF returns a new pdf renderer that writes a standalone, single page document.
+// One pixel of the chart maps to one point of the page.
+// Text is drawn with the truetype fonts of `Style.Font`, which are embedded in the document.
+func PDF(width, height int) (Renderer, error) {
+	return &pdfRenderer{
+		width:     width,
+		height:    height,
+		dpi:       DefaultDPI,
+		s:         &Style{},
+		p:         bytes.NewBuffer([]byte{}),
+		c:         bytes.NewBuffer([]byte{}),
+		gstates:   map[[2]uint8]string{},
+		fontFaces: map[*truetype.Font]*pdfFont{},
+	}, nil
+}
+
+// pdfRenderer renders chart commands to a pdf content stream.
+type pdfRenderer struct {
+	width  int
+	height int
+	dpi    float64
+	s      *Style
+
+	// p is the path under construction, c is the page content stream.
+	p *bytes.Buffer
+	c *bytes.Buffer
+
+	// x and y are the current point of the path.
+	x, y float64
+
+	textTheta *float64
+	fc        *font.Drawer
+
+	gstates   map[[2]uint8]string
+	alphas    [][2]uint8
+	fonts     []*pdfFont
+	fontFaces map[*truetype.Font]*pdfFont
+}
+
+// pdfFont is a truetype font embedded as a composite font whose two byte codes are glyph indexes.
+// It keeps the glyphs drawn with it, and the rune each glyph was drawn for, to list their widths and text.
+type pdfFont struct {
+	name   string
+	font   *truetype.Font
+	glyphs []truetype.Index
+	runes  map[truetype.Index]rune
+}
+
+func (pr *pdfRenderer) ResetStyle() {
+	pr.s = &Style{Font: pr.s.Font}
+	pr.fc = nil
+	pr.ClearTextRotation()
+}
+
+// GetDPI returns the dpi.
+func (pr *pdfRenderer) GetDPI() float64 {
+	return pr.dpi
+}
+
+// SetDPI implements the interface method.
+func (pr *pdfRenderer) SetDPI(dpi float64) {
+	pr.dpi = dpi
+}
+
+// SetStrokeColor implements the interface method.
+func (pr *pdfRenderer) SetStrokeColor(c drawing.Color) {
+	pr.s.StrokeColor = c
+}
+
+// SetFillColor implements the interface method.
+func (pr *pdfRenderer) SetFillColor(c drawing.Color) {
+	pr.s.FillColor = c
+}
+
+// SetStrokeWidth implements the interface method.
+func (pr *pdfRenderer) SetStrokeWidth(width float64) {
+	pr.s.StrokeWidth = width
+}
+
+// SetStrokeDashArray sets the stroke dash array.
+func (pr *pdfRenderer) SetStrokeDashArray(dashArray []float64) {
+	pr.s.StrokeDashArray = dashArray
+}
+
+// MoveTo implements the interface method.
+func (pr *pdfRenderer) MoveTo(x, y int) {
+	pr.moveTo(float64(x), float64(y))
+}
+
+// LineTo implements the interface method.
+func (pr *pdfRenderer) LineTo(x, y int) {
+	pr.lineTo(float64(x), float64(y))
+}
+
+// QuadCurveTo implements the interface method.
+func (pr *pdfRenderer) QuadCurveTo(cx, cy, x, y int) {
+	pr.quadCurveTo(float64(cx), float64(cy), float64(x), float64(y))
+}
+
+// ArcTo implements the interface method.
+// Like the raster renderer, it draws a line to the start of the arc if a path has been started,
+// and approximates the arc with a cubic bezier curve for every quarter turn.
+func (pr *pdfRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	fcx, fcy := float64(cx), float64(cy)
+
+	startX := fcx + math.Cos(startAngle)*rx
+	startY := fcy + math.Sin(startAngle)*ry
+	if pr.p.Len() > 0 {
+		pr.lineTo(startX, startY)
+	} else {
+		pr.moveTo(startX, startY)
+	}
+
+	segments := int(math.Ceil(math.Abs(delta) / _pi2))
+	if segments == 0 {
+		return
+	}
+	step := delta / float64(segments)
+	k := (4.0 / 3.0) * math.Tan(step/4.0)
+
+	a0 := startAngle
+	for index := 0; index < segments; index++ {
+		a1 := a0 + step
+		x0, y0 := fcx+math.Cos(a0)*rx, fcy+math.Sin(a0)*ry
+		x1, y1 := fcx+math.Cos(a1)*rx, fcy+math.Sin(a1)*ry
+		pr.cubicCurveTo(
+			x0-k*rx*math.Sin(a0), y0+k*ry*math.Cos(a0),
+			x1+k*rx*math.Sin(a1), y1-k*ry*math.Cos(a1),
+			x1, y1,
+		)
+		a0 = a1
+	}
+}
+
+// Close implements the interface method.
+func (pr *pdfRenderer) Close() {
+	pr.p.WriteString("h\n")
+}
+
+// Stroke implements the interface method.
+func (pr *pdfRenderer) Stroke() {
+	pr.drawPath(true, false)
+}
+
+// Fill implements the interface method.
+func (pr *pdfRenderer) Fill() {
+	pr.drawPath(false, true)
+}
+
+// FillStroke implements the interface method.
+func (pr *pdfRenderer) FillStroke() {
+	pr.drawPath(true, true)
+}
+
+// Circle adds a circle at a given point to the path but does not apply the fill or stroke.
+func (pr *pdfRenderer) Circle(radius float64, x, y int) {
+	pr.moveTo(float64(x)+radius, float64(y))
+	pr.ArcTo(x, y, radius, radius, 0, 2*math.Pi)
+	pr.Close()
+}
+
+// SetFont implements the interface method.
+func (pr *pdfRenderer) SetFont(f *truetype.Font) {
+	pr.s.Font = f
+}
+
+// SetFontColor implements the interface method.
+func (pr *pdfRenderer) SetFontColor(c drawing.Color) {
+	pr.s.FontColor = c
+}
+
+// SetFontSize implements the interface method.
+func (pr *pdfRenderer) SetFontSize(size float64) {
+	pr.s.FontSize = size
+}
+
+// Text draws a text blob with the baseline starting at x, y.
+func (pr *pdfRenderer) Text(body string, x, y int) {
+	f := pr.s.GetFont()
+	if f == nil || len(body) == 0 || pr.s.FontColor.IsTransparent() {
+		return
+	}
+
+	size := drawing.PointsToPixels(pr.dpi, pr.s.FontSize)
+	var theta float64
+	if pr.textTheta != nil {
+		theta = *pr.textTheta
+	}
+	// the page is flipped so y grows down; flip the glyphs back upright, then rotate.
+	sin, cos := math.Sincos(theta)
+
+	pr.c.WriteString("q\n")
+	pr.writeAlpha(255, pr.s.FontColor.A)
+	pr.c.WriteString(pdfColor(pr.s.FontColor) + " rg\n")
+	pr.c.WriteString("BT\n")
+	pr.c.WriteString(fmt.Sprintf("%s %s %s %s %d %d Tm\n",
+		pdfNumber(cos*size), pdfNumber(sin*size), pdfNumber(sin*size), pdfNumber(-cos*size), x, y))
+
+	pf := pr.getFont(f)
+	pr.c.WriteString(fmt.Sprintf("/%s 1 Tf\n", pf.name))
+
+	scale := fixed.Int26_6(pdfGlyphUnitsPerEm << 6)
+	var run []string
+	prev, hasPrev := truetype.Index(0), false
+	for _, c := range body {
+		index := f.Index(c)
+		if hasPrev {
+			if kern := f.Kern(scale, prev, index); kern != 0 {
+				// TJ adjustments are subtracted from the position, in thousandths of an em.
+				run = append(run, pdfNumber(-float64(kern)/64.0))
+			}
+		}
+		prev, hasPrev = index, true
+
+		pf.addGlyph(index, c)
+		run = append(run, fmt.Sprintf("<%04X>", index))
+	}
+	pr.c.WriteString("[" + strings.Join(run, " ") + "] TJ\n")
+	pr.c.WriteString("ET\nQ\n")
+}
+
+// MeasureText uses the truetype font drawer to measure the width of text.
+func (pr *pdfRenderer) MeasureText(body string) (box Box) {
+	if pr.s.GetFont() != nil {
+		pr.fc = &font.Drawer{
+			Face: truetype.NewFace(pr.s.GetFont(), &truetype.Options{
+				DPI:  pr.dpi,
+				Size: pr.s.FontSize,
+			}),
+		}
+		w := pr.fc.MeasureString(body).Ceil()
+
+		box.Right = w
+		box.Bottom = int(drawing.PointsToPixels(pr.dpi, pr.s.FontSize))
+		if pr.textTheta == nil {
+			return
+		}
+		box = box.Corners().Rotate(util.Math.RadiansToDegrees(*pr.textTheta)).Box()
+	}
+	return
+}
+
+// SetTextRotation sets the text rotation.
+func (pr *pdfRenderer) SetTextRotation(radians float64) {
+	pr.textTheta = &radians
+}
+
+// ClearTextRotation clears the text rotation.
+func (pr *pdfRenderer) ClearTextRotation() {
+	pr.textTheta = nil
+}
+
+// Save writes the document to a writer.
+func (pr *pdfRenderer) Save(w io.Writer) error {
+	const (
+		catalogID = iota + 1
+		pagesID
+		pageID
+		contentsID
+		firstFontID
+	)
+
+	// every font takes up five objects: the composite font, its descendant truetype font,
+	// the font descriptor, the font program and the unicode map.
+	objects := map[int][]byte{}
+	nextID := firstFontID
+	fontIDs := map[string]int{}
+	for _, pf := range pr.fonts {
+		fontID, cidFontID, descriptorID, fontFileID, toUnicodeID := nextID, nextID+1, nextID+2, nextID+3, nextID+4
+		nextID += 5
+
+		fontFile, err := pdfFontFile(pf.font)
+		if err != nil {
+			return err
+		}
+
+		scale := fixed.Int26_6(pdfGlyphUnitsPerEm << 6)
+		sort.Slice(pf.glyphs, func(i, j int) bool { return pf.glyphs[i] < pf.glyphs[j] })
+		var widths []string
+		for _, index := range pf.glyphs {
+			widths = append(widths, fmt.Sprintf("%d [%s]", index, pdfNumber(float64(pf.font.HMetric(scale, index).AdvanceWidth)/64.0)))
+		}
+
+		baseFont := pdfFontName(pf)
+		bounds := pf.font.Bounds(scale)
+		objects[fontID] = []byte(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H "+
+			"/DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>", baseFont, cidFontID, toUnicodeID))
+		objects[cidFontID] = []byte(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /CIDToGIDMap /Identity /W [%s] >>", baseFont, descriptorID, strings.Join(widths, " ")))
+		objects[descriptorID] = []byte(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 "+
+			"/FontBBox [%s %s %s %s] /ItalicAngle 0 /Ascent %s /Descent %s /CapHeight %s /StemV 80 /FontFile2 %d 0 R >>",
+			baseFont,
+			pdfNumber(float64(bounds.Min.X)/64.0), pdfNumber(float64(bounds.Min.Y)/64.0),
+			pdfNumber(float64(bounds.Max.X)/64.0), pdfNumber(float64(bounds.Max.Y)/64.0),
+			pdfNumber(float64(bounds.Max.Y)/64.0), pdfNumber(float64(bounds.Min.Y)/64.0), pdfNumber(float64(bounds.Max.Y)/64.0),
+			fontFileID,
+		))
+		objects[fontFileID] = pdfStream(string(fontFile), fmt.Sprintf("/Length1 %d", len(fontFile)))
+		objects[toUnicodeID] = pdfStream(pdfToUnicode(pf.glyphs, pf.runes))
+		fontIDs[pf.name] = fontID
+	}
+
+	var fontResources []string
+	for _, pf := range pr.fonts {
+		fontResources = append(fontResources, fmt.Sprintf("/%s %d 0 R", pf.name, fontIDs[pf.name]))
+	}
+	var gstateResources []string
+	for _, alphas := range pr.alphas {
+		name := pr.gstates[alphas]
+		gstateResources = append(gstateResources, fmt.Sprintf("/%s << /Type /ExtGState /CA %s /ca %s >>",
+			name, pdfNumber(float64(alphas[0])/255.0), pdfNumber(float64(alphas[1])/255.0)))
+	}
+
+	// flip the page so the origin is at the top left with y growing down, like the other renderers.
+	content := fmt.Sprintf("1 0 0 -1 0 %d cm\n", pr.height) + pr.c.String()
+
+	objects[catalogID] = []byte(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+	objects[pagesID] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%d 0 R] /Count 1 >>", pageID))
+	objects[pageID] = []byte(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] "+
+		"/Resources << /Font << %s >> /ExtGState << %s >> >> /Contents %d 0 R >>",
+		pagesID, pr.width, pr.height, strings.Join(fontResources, " "), strings.Join(gstateResources, " "), contentsID))
+	objects[contentsID] = pdfStream(content)
+
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, nextID)
+	for id := 1; id < nextID; id++ {
+		offsets[id] = buffer.Len()
+		buffer.WriteString(fmt.Sprintf("%d 0 obj\n", id))
+		buffer.Write(objects[id])
+		buffer.WriteString("\nendobj\n")
+	}
+
+	xref := buffer.Len()
+	buffer.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", nextID))
+	for id := 1; id < nextID; id++ {
+		buffer.WriteString(fmt.Sprintf("%010d 00000 n \n", offsets[id]))
+	}
+	buffer.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", nextID, catalogID, xref))
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+func (pr *pdfRenderer) moveTo(x, y float64) {
+	pr.p.WriteString(fmt.Sprintf("%s %s m\n", pdfNumber(x), pdfNumber(y)))
+	pr.x, pr.y = x, y
+}
+
+func (pr *pdfRenderer) lineTo(x, y float64) {
+	if pr.p.Len() == 0 {
+		pr.moveTo(x, y)
+		return
+	}
+	pr.p.WriteString(fmt.Sprintf("%s %s l\n", pdfNumber(x), pdfNumber(y)))
+	pr.x, pr.y = x, y
+}
+
+// quadCurveTo draws a quadratic curve as the equivalent cubic curve, as pdf only supports the latter.
+func (pr *pdfRenderer) quadCurveTo(cx, cy, x, y float64) {
+	pr.cubicCurveTo(
+		pr.x+(2.0/3.0)*(cx-pr.x), pr.y+(2.0/3.0)*(cy-pr.y),
+		x+(2.0/3.0)*(cx-x), y+(2.0/3.0)*(cy-y),
+		x, y,
+	)
+}
+
+func (pr *pdfRenderer) cubicCurveTo(cx1, cy1, cx2, cy2, x, y float64) {
+	pr.p.WriteString(fmt.Sprintf("%s %s %s %s %s %s c\n",
+		pdfNumber(cx1), pdfNumber(cy1), pdfNumber(cx2), pdfNumber(cy2), pdfNumber(x), pdfNumber(y)))
+	pr.x, pr.y = x, y
+}
+
+// drawPath paints the current path with the current style and starts a new path.
+func (pr *pdfRenderer) drawPath(stroke, fill bool) {
+	defer pr.p.Reset()
+	if pr.p.Len() == 0 {
+		return
+	}
+
+	// a fully transparent color has no effect, and pdf colors are opaque unless told otherwise.
+	stroke = stroke && pr.s.ShouldDrawStroke() && !pr.s.StrokeColor.IsTransparent()
+	fill = fill && pr.s.ShouldDrawFill() && !pr.s.FillColor.IsTransparent()
+	if !stroke && !fill {
+		return
+	}
+
+	pr.c.WriteString("q\n")
+
+	strokeAlpha, fillAlpha := uint8(255), uint8(255)
+	if stroke {
+		strokeAlpha = pr.s.StrokeColor.A
+		pr.c.WriteString(pdfColor(pr.s.StrokeColor) + " RG\n")
+		pr.c.WriteString(pdfNumber(pr.s.StrokeWidth) + " w\n")
+		if len(pr.s.StrokeDashArray) > 0 {
+			var dashes []string
+			for _, v := range pr.s.StrokeDashArray {
+				dashes = append(dashes, pdfNumber(v))
+			}
+			pr.c.WriteString("[" + strings.Join(dashes, " ") + "] 0 d\n")
+		}
+	}
+	if fill {
+		fillAlpha = pr.s.FillColor.A
+		pr.c.WriteString(pdfColor(pr.s.FillColor) + " rg\n")
+	}
+	pr.writeAlpha(strokeAlpha, fillAlpha)
+
+	pr.c.Write(pr.p.Bytes())
+	switch {
+	case stroke && fill:
+		pr.c.WriteString("B\n")
+	case stroke:
+		pr.c.WriteString("S\n")
+	default:
+		pr.c.WriteString("f\n")
+	}
+	pr.c.WriteString("Q\n")
+}
+
+// writeAlpha sets the stroke and fill opacity if either is not fully opaque.
+func (pr *pdfRenderer) writeAlpha(strokeAlpha, fillAlpha uint8) {
+	if strokeAlpha == 255 && fillAlpha == 255 {
+		return
+	}
+
+	key := [2]uint8{strokeAlpha, fillAlpha}
+	name, hasName := pr.gstates[key]
+	if !hasName {
+		name = fmt.Sprintf("GS%d", len(pr.gstates)+1)
+		pr.gstates[key] = name
+		pr.alphas = append(pr.alphas, key)
+	}
+	pr.c.WriteString("/" + name + " gs\n")
+}
+
+// getFont returns the embedded font for a truetype font, adding it if it hasn't been used yet.
+func (pr *pdfRenderer) getFont(f *truetype.Font) *pdfFont {
+	if pf, hasFont := pr.fontFaces[f]; hasFont {
+		return pf
+	}
+	pf := &pdfFont{
+		name:  fmt.Sprintf("F%d", len(pr.fonts)+1),
+		font:  f,
+		runes: map[truetype.Index]rune{},
+	}
+	pr.fonts = append(pr.fonts, pf)
+	pr.fontFaces[f] = pf
+	return pf
+}
+
+// addGlyph records that a glyph has been drawn for a rune, the first rune drawn with a glyph is its text.
+func (pf *pdfFont) addGlyph(index truetype.Index, c rune) {
+	if _, hasGlyph := pf.runes[index]; hasGlyph {
+		return
+	}
+	pf.runes[index] = c
+	pf.glyphs = append(pf.glyphs, index)
+}
+
+// pdfFontName returns the postscript name of a font, or the resource name of the font if it has none.
+func pdfFontName(pf *pdfFont) string {
+	name := strings.Map(func(c rune) rune {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_' {
+			return c
+		}
+		return -1
+	}, pf.font.Name(truetype.NameIDPostscriptName))
+	if len(name) == 0 {
+		return pf.name
+	}
+	return name
+}
+
+// pdfFontFile returns the font program of a truetype font, rebuilt from the tables it was parsed from.
+// `truetype.Font` doesn't keep the original file, only the tables in unexported fields, which are read by reflection.
+func pdfFontFile(f *truetype.Font) ([]byte, error) {
+	value := reflect.ValueOf(f).Elem()
+	var tags []string
+	var tables [][]byte
+	for _, table := range pdfFontTables {
+		field := value.FieldByName(table[1])
+		if !field.IsValid() || field.Kind() != reflect.Slice || field.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("cannot read the %q table of the font", table[0])
+		}
+		if data := field.Bytes(); len(data) > 0 {
+			tags = append(tags, table[0])
+			tables = append(tables, append([]byte{}, data...))
+		}
+	}
+
+	var entrySelector int
+	for 2<<uint(entrySelector) <= len(tables) {
+		entrySelector++
+	}
+	searchRange := 16 << uint(entrySelector)
+
+	header := make([]byte, 12+16*len(tables))
+	binary.BigEndian.PutUint32(header[0:], 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(len(tables)))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*len(tables)-searchRange))
+
+	headOffset := -1
+	offset := len(header)
+	for index, table := range tables {
+		if tags[index] == "head" && len(table) >= 12 {
+			// the checksum adjustment of the whole file is computed with the field zeroed.
+			binary.BigEndian.PutUint32(table[8:], 0)
+			headOffset = offset
+		}
+		entry := header[12+16*index:]
+		copy(entry, tags[index])
+		binary.BigEndian.PutUint32(entry[4:], pdfFontChecksum(table))
+		binary.BigEndian.PutUint32(entry[8:], uint32(offset))
+		binary.BigEndian.PutUint32(entry[12:], uint32(len(table)))
+		offset += (len(table) + 3) &^ 3
+	}
+
+	file := make([]byte, 0, offset)
+	file = append(file, header...)
+	for _, table := range tables {
+		file = append(file, table...)
+		file = append(file, make([]byte, ((len(table)+3)&^3)-len(table))...)
+	}
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(file[headOffset+8:], 0xB1B0AFBA-pdfFontChecksum(file))
+	}
+	return file, nil
+}
+
+// pdfFontChecksum returns the sum of the big endian words of a font table, padded with zeros.
+func pdfFontChecksum(data []byte) uint32 {
+	var sum uint32
+	for index := 0; index < len(data); index += 4 {
+		var word [4]byte
+		copy(word[:], data[index:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// pdfToUnicode returns a cmap that maps the glyph indexes of a font back to text,
+// which lets viewers search and copy the text.
+func pdfToUnicode(glyphs []truetype.Index, runes map[truetype.Index]rune) string {
+	b := bytes.NewBuffer([]byte{})
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// a cmap section can hold at most 100 mappings.
+	for start := 0; start < len(glyphs); start += 100 {
+		end := util.Math.MinInt(start+100, len(glyphs))
+		b.WriteString(fmt.Sprintf("%d beginbfchar\n", end-start))
+		for _, index := range glyphs[start:end] {
+			var units []string
+			for _, u := range utf16.Encode([]rune{runes[index]}) {
+				units = append(units, fmt.Sprintf("%04X", u))
+			}
+			b.WriteString(fmt.Sprintf("<%04X> <%s>\n", index, strings.Join(units, "")))
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.String()
+}
+
+// pdfStream returns a compressed stream object, with any extra entries of its dictionary.
+func pdfStream(content string, entries ...string) []byte {
+	compressed := bytes.NewBuffer([]byte{})
+	zw := zlib.NewWriter(compressed)
+	zw.Write([]byte(content))
+	zw.Close()
+
+	b := bytes.NewBuffer([]byte{})
+	b.WriteString(fmt.Sprintf("<< /Length %d /Filter /FlateDecode", compressed.Len()))
+	for _, entry := range entries {
+		b.WriteString(" " + entry)
+	}
+	b.WriteString(" >>\nstream\n")
+	b.Write(compressed.Bytes())
+	b.WriteString("\nendstream")
+	return b.Bytes()
+}
+
+// pdfColor returns the rgb components of a color as pdf operands.
+func pdfColor(c drawing.Color) string {
+	return fmt.Sprintf("%s %s %s", pdfNumber(float64(c.R)/255.0), pdfNumber(float64(c.G)/255.0), pdfNumber(float64(c.B)/255.0))
+}
+
+// pdfNumber formats a number with at most 3 decimal places and no trailing zeros.
+func pdfNumber(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
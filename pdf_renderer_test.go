@@ -0,0 +1,207 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestPDFRendererPath(t *testing.T) {
+	assert := assert.New(t)
+
+	pr, err := PDF(100, 100)
+	assert.Nil(err)
+
+	typed, isTyped := pr.(*pdfRenderer)
+	assert.True(isTyped)
+
+	typed.SetStrokeColor(drawing.ColorBlack)
+	typed.SetStrokeWidth(2)
+	typed.SetFillColor(drawing.ColorBlue.WithAlpha(128))
+	typed.MoveTo(0, 0)
+	typed.LineTo(100, 100)
+	typed.LineTo(0, 100)
+	typed.Close()
+	typed.FillStroke()
+
+	assert.True(strings.Contains(typed.c.String(), "0 0 m\n100 100 l\n0 100 l\nh\nB\n"))
+	assert.True(strings.Contains(typed.c.String(), "/GS1 gs\n"))
+
+	buffer := bytes.NewBuffer([]byte{})
+	err = typed.Save(buffer)
+	assert.Nil(err)
+
+	raw := string(buffer.Bytes())
+
+	assert.True(strings.HasPrefix(raw, "%PDF-1.4"))
+	assert.True(strings.HasSuffix(raw, "%%EOF\n"))
+	assert.True(strings.Contains(raw, "/MediaBox [0 0 100 100]"))
+	assert.True(strings.Contains(raw, "/GS1 << /Type /ExtGState /CA 1 /ca 0.502 >>"))
+}
+
+func TestPDFRendererTransparent(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	pr, err := PDF(100, 100)
+	assert.Nil(err)
+
+	typed := pr.(*pdfRenderer)
+	typed.SetFillColor(ColorTransparent)
+	typed.MoveTo(0, 0)
+	typed.LineTo(100, 100)
+	typed.Close()
+	typed.Fill()
+
+	typed.SetStrokeColor(ColorTransparent)
+	typed.SetStrokeWidth(2)
+	typed.SetFillColor(drawing.ColorBlue)
+	typed.MoveTo(0, 0)
+	typed.LineTo(100, 100)
+	typed.Close()
+	typed.FillStroke()
+
+	typed.SetFont(f)
+	typed.SetFontColor(ColorTransparent)
+	typed.Text("abba", 10, 20)
+
+	content := typed.c.String()
+	assert.Equal(1, strings.Count(content, "q\n"))
+	assert.True(strings.Contains(content, "0 0 1 rg\n0 0 m\n100 100 l\nh\nf\n"))
+	assert.False(strings.Contains(content, "RG\n"))
+	assert.False(strings.Contains(content, "BT\n"))
+	assert.False(strings.Contains(content, " gs\n"))
+}
+
+func TestPDFRendererMeasureText(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	pr, err := PDF(100, 100)
+	assert.Nil(err)
+
+	pr.SetDPI(DefaultDPI)
+	pr.SetFont(f)
+	pr.SetFontSize(12.0)
+
+	tb := pr.MeasureText("Ljp")
+	assert.Equal(21, tb.Width())
+	assert.Equal(15, tb.Height())
+}
+
+func TestPDFRendererText(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	pr, err := PDF(100, 100)
+	assert.Nil(err)
+
+	typed := pr.(*pdfRenderer)
+	typed.SetFont(f)
+	typed.SetFontSize(12.0)
+	typed.SetFontColor(drawing.ColorBlack)
+	typed.Text("abba", 10, 20)
+
+	typed.SetTextRotation(math.Pi / 2)
+	typed.Text("c", 30, 40)
+	typed.ResetStyle()
+	assert.Nil(typed.textTheta)
+
+	a, b, c := f.Index('a'), f.Index('b'), f.Index('c')
+	content := typed.c.String()
+	assert.True(strings.Contains(content, fmt.Sprintf("15.333 0 0 -15.333 10 20 Tm\n/F1 1 Tf\n[<%04X> <%04X> <%04X> <%04X>] TJ\n", a, b, b, a)))
+	assert.True(strings.Contains(content, fmt.Sprintf("0 15.333 15.333 0 30 40 Tm\n/F1 1 Tf\n[<%04X>] TJ\n", c)))
+
+	assert.Len(1, typed.fonts)
+	assert.Equal([]truetype.Index{a, b, c}, typed.fonts[0].glyphs)
+	assert.Equal('c', typed.fonts[0].runes[c])
+}
+
+func TestPDFRendererDocument(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "Test Chart",
+		TitleStyle: StyleShow(),
+		XAxis:      XAxis{Style: StyleShow()},
+		YAxis:      YAxis{Style: StyleShow(), Name: "Values", NameStyle: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{Show: true, StrokeColor: ColorBlue, StrokeDashArray: []float64{5, 3}},
+				XValues: []float64{1, 2, 3, 4},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PDF, buffer))
+	raw := buffer.String()
+
+	assert.True(strings.Contains(raw, "/Subtype /Type0 /BaseFont /Roboto-Medium /Encoding /Identity-H"))
+	assert.True(strings.Contains(raw, "/Subtype /CIDFontType2"))
+	assert.True(strings.Contains(raw, "/FontFile2"))
+	assert.True(strings.Contains(raw, "/ToUnicode"))
+
+	// the cross reference table must point at each object.
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(raw)
+	assert.Len(2, startxref)
+	xref, err := strconv.Atoi(startxref[1])
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(raw[xref:], "xref\n"))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(raw[xref:], -1)
+	assert.NotEmpty(entries)
+	for index, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		assert.Nil(err)
+		assert.True(strings.HasPrefix(raw[offset:], fmt.Sprintf("%d 0 obj\n", index+1)))
+	}
+}
+
+func TestPDFToUnicode(t *testing.T) {
+	assert := assert.New(t)
+
+	cmap := pdfToUnicode([]truetype.Index{3, 300}, map[truetype.Index]rune{3: 'a', 300: '€'})
+	assert.True(strings.Contains(cmap, "<0000> <FFFF>"))
+	assert.True(strings.Contains(cmap, "2 beginbfchar\n<0003> <0061>\n<012C> <20AC>\nendbfchar"))
+}
+
+func TestPDFFontFile(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	file, err := pdfFontFile(f)
+	assert.Nil(err)
+	assert.Zero(len(file) % 4)
+
+	// the rebuilt font program parses to the same glyphs and metrics.
+	parsed, err := truetype.Parse(file)
+	assert.Nil(err)
+	scale := fixed.Int26_6(pdfGlyphUnitsPerEm << 6)
+	for _, c := range "Ljp0€" {
+		assert.Equal(f.Index(c), parsed.Index(c))
+		assert.Equal(f.HMetric(scale, f.Index(c)), parsed.HMetric(scale, parsed.Index(c)))
+	}
+	assert.Equal(f.Bounds(scale), parsed.Bounds(scale))
+
+	// the whole file sums to the magic number of the checksum adjustment.
+	assert.Equal(uint32(0xB1B0AFBA), pdfFontChecksum(file))
+}
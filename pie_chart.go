@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/daill/go-chart/util"
@@ -16,12 +17,42 @@ const (
 	_pi4 = math.Pi / 4.0
 )
 
+const (
+	// DefaultPieLeaderLineLength is the length of each segment of the lines from the slices to outside labels.
+	DefaultPieLeaderLineLength = 12
+	// DefaultPieLabelSpacing is the space between outside labels and their leader lines.
+	DefaultPieLabelSpacing = 4
+)
+
+// PieDirection is the direction the slices of a pie chart are drawn in.
+type PieDirection int
+
+const (
+	// PieDirectionClockwise draws the slices clockwise, the default.
+	PieDirectionClockwise PieDirection = iota
+	// PieDirectionCounterClockwise draws the slices counter clockwise.
+	PieDirectionCounterClockwise
+)
+
+// PieLabelPosition is where the labels of a pie chart are drawn.
+type PieLabelPosition int
+
+const (
+	// PieLabelPositionInside draws the labels inside the slices, the default.
+	PieLabelPositionInside PieLabelPosition = iota
+	// PieLabelPositionOutside draws the labels in columns left and right of the pie,
+	// connected to their slices by leader lines.
+	PieLabelPositionOutside
+)
+
 // PieChart is a chart that draws sections of a circle based on percentages.
 type PieChart struct {
 	Title      string
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -31,6 +62,30 @@ type PieChart struct {
 	Canvas     Style
 	SliceStyle Style
 
+	// InnerRadius is the radius of the hole of a donut chart as a fraction of the radius, i.e. 0.5,
+	// zero draws a full pie.
+	InnerRadius float64
+	// CenterText is drawn in the hole of a donut chart, e.g. the total of the values.
+	CenterText      string
+	CenterTextStyle Style
+
+	// StartAngle is the angle in degrees the first slice starts at, clockwise from 3 o'clock,
+	// i.e. -90 starts at 12 o'clock.
+	StartAngle float64
+	// Direction is the direction the slices are drawn in.
+	Direction PieDirection
+	// Explode are the offsets of the slices away from the center as a fraction of the radius,
+	// by index of `Values`.
+	Explode []float64
+
+	// LabelPosition is where the labels of the slices are drawn.
+	LabelPosition PieLabelPosition
+	// LeaderLineStyle is the style of the lines to outside labels, it inherits the color of the slice.
+	LeaderLineStyle Style
+
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -52,6 +107,9 @@ func (pc PieChart) GetDPI(defaults ...float64) float64 {
 // GetFont returns the text font.
 func (pc PieChart) GetFont() *truetype.Font {
 	if pc.Font == nil {
+		if theme := pc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return pc.defaultFont
 	}
 	return pc.Font
@@ -73,10 +131,18 @@ func (pc PieChart) GetHeight() int {
 	return pc.Height
 }
 
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (pc PieChart) Validate() error {
+	if len(pc.Values) == 0 {
+		return FieldError{Field: "Values", Err: errors.New("please provide at least one value")}
+	}
+	return nil
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(pc.Values) == 0 {
-		return errors.New("please provide at least one value")
+	if err := pc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(pc.GetWidth(), pc.GetHeight())
@@ -93,8 +159,11 @@ func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
 	}
 	r.SetDPI(pc.GetDPI(DefaultDPI))
 
-	canvasBox := pc.getDefaultCanvasBox()
-	canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	legend := pc.getLegend()
+	canvasBox := legend.adjustCanvasBox(r, pc.getDefaultCanvasBox())
+	if pc.LabelPosition != PieLabelPositionOutside {
+		canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	}
 
 	pc.drawBackground(r)
 	pc.drawCanvas(r, canvasBox)
@@ -104,6 +173,8 @@ func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
 		return err
 	}
 	pc.drawSlices(r, canvasBox, finalValues)
+	legend.draw(r, pc.getDefaultCanvasBox(), canvasBox)
+
 	pc.drawTitle(r)
 	for _, a := range pc.Elements {
 		a(r, canvasBox, pc.styleDefaultsElements())
@@ -112,6 +183,13 @@ func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// RenderInto renders the chart into a box of an existing renderer.
+func (pc PieChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	pc.Width, pc.Height, pc.DPI = box.Width(), box.Height(), pc.GetDPI(r.GetDPI())
+	return pc.Render(BoxRendererProvider(r, box), nil)
+}
+
 func (pc PieChart) drawBackground(r Renderer) {
 	Draw.Box(r, Box{
 		Right:  pc.GetWidth(),
@@ -129,49 +207,213 @@ func (pc PieChart) drawTitle(r Renderer) {
 	}
 }
 
+// pieSlice is a value of a pie chart laid out on the canvas.
+type pieSlice struct {
+	Value
+	// style is the style of the value inheriting the slice defaults.
+	style Style
+
+	// start and delta are the angles of the slice in radians.
+	start, delta float64
+	// cx and cy are the center of the slice after exploding it.
+	cx, cy int
+}
+
+func (ps pieSlice) mid() float64 {
+	return ps.start + ps.delta/2.0
+}
+
+// point returns the point at the given distance from the center of the slice through its middle.
+func (ps pieSlice) point(radius float64) (x, y int) {
+	return ps.cx + int(math.Round(radius*math.Cos(ps.mid()))), ps.cy + int(math.Round(radius*math.Sin(ps.mid())))
+}
+
+// pieLabel is an outside label of a pie chart, y is the center of the text.
+type pieLabel struct {
+	slice            pieSlice
+	text             Box
+	anchorX, anchorY int
+	elbowX, y        int
+	right            bool
+}
+
 func (pc PieChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
 	cx, cy := canvasBox.Center()
-	diameter := util.Math.MinInt(canvasBox.Width(), canvasBox.Height())
-	radius := float64(diameter >> 1)
-	labelRadius := (radius * 2.0) / 3.0
-
-	// draw the pie slices
-	var rads, delta, delta2, total float64
-	var lx, ly int
-	for index, v := range values {
-		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+	explode, maxExplode := pc.getExplode()
+
+	var radius float64
+	if pc.LabelPosition == PieLabelPositionOutside {
+		var labelWidth, labelHeight int
+		for index, v := range values {
+			if len(v.Label) > 0 {
+				v.Style.InheritFrom(pc.stylePieChartValue(index)).GetTextOptions().WriteToRenderer(r)
+				tb := r.MeasureText(v.Label)
+				labelWidth = util.Math.MaxInt(labelWidth, tb.Width())
+				labelHeight = util.Math.MaxInt(labelHeight, tb.Height())
+			}
+		}
+		radius = float64(util.Math.MinInt(
+			(canvasBox.Width()>>1)-labelWidth-2*DefaultPieLeaderLineLength-DefaultPieLabelSpacing,
+			(canvasBox.Height()>>1)-labelHeight,
+		))
+	} else {
+		radius = float64(util.Math.MinInt(canvasBox.Width(), canvasBox.Height()) >> 1)
+	}
+	radius = math.Max(radius/(1.0+maxExplode), 1.0)
+	innerRadius := radius * math.Min(math.Max(pc.InnerRadius, 0), 1)
 
-		r.MoveTo(cx, cy)
-		rads = util.Math.PercentToRadians(total)
-		delta = util.Math.PercentToRadians(v.Value)
+	direction := 1.0
+	if pc.Direction == PieDirectionCounterClockwise {
+		direction = -1.0
+	}
 
-		r.ArcTo(cx, cy, radius, radius, rads, delta)
+	slices := make([]pieSlice, len(values))
+	var total float64
+	for index, v := range values {
+		slice := pieSlice{
+			Value: v,
+			style: v.Style.InheritFrom(pc.stylePieChartValue(index)),
+			start: util.Math.DegreesToRadians(pc.StartAngle) + direction*util.Math.PercentToRadians(total),
+			delta: direction * util.Math.PercentToRadians(v.Value),
+			cx:    cx,
+			cy:    cy,
+		}
+		slice.cx, slice.cy = slice.point(explode[index] * radius)
+		slices[index] = slice
+		total = total + v.Value
+	}
 
-		r.LineTo(cx, cy)
+	// draw the pie slices
+	for _, slice := range slices {
+		slice.style.WriteToRenderer(r)
+		if innerRadius > 0 {
+			r.ArcTo(slice.cx, slice.cy, radius, radius, slice.start, slice.delta)
+			r.ArcTo(slice.cx, slice.cy, innerRadius, innerRadius, slice.start+slice.delta, -slice.delta)
+		} else {
+			r.MoveTo(slice.cx, slice.cy)
+			r.ArcTo(slice.cx, slice.cy, radius, radius, slice.start, slice.delta)
+			r.LineTo(slice.cx, slice.cy)
+		}
 		r.Close()
 		r.FillStroke()
-		total = total + v.Value
+	}
+
+	if innerRadius > 0 && len(pc.CenterText) > 0 {
+		// the largest square fitting the hole.
+		half := int(innerRadius / math.Sqrt2)
+		Draw.TextWithin(r, pc.CenterText, Box{Top: cy - half, Left: cx - half, Right: cx + half, Bottom: cy + half}, pc.styleDefaultsCenterText())
 	}
 
 	// draw the labels
-	total = 0
-	for index, v := range values {
-		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
-		if len(v.Label) > 0 {
-			delta2 = util.Math.PercentToRadians(total + (v.Value / 2.0))
-			delta2 = util.Math.RadianAdd(delta2, _pi2)
-			lx, ly = util.Math.CirclePoint(cx, cy, labelRadius, delta2)
+	if pc.LabelPosition == PieLabelPositionOutside {
+		pc.drawOutsideLabels(r, canvasBox, slices, radius, radius*(1.0+maxExplode))
+		return
+	}
+
+	labelRadius := (radius * 2.0) / 3.0
+	if innerRadius > 0 {
+		labelRadius = (radius + innerRadius) / 2.0
+	}
+	for _, slice := range slices {
+		if len(slice.Label) > 0 {
+			slice.style.WriteToRenderer(r)
+			lx, ly := slice.point(labelRadius)
 
-			tb := r.MeasureText(v.Label)
+			tb := r.MeasureText(slice.Label)
 			lx = lx - (tb.Width() >> 1)
 			ly = ly + (tb.Height() >> 1)
 
-			r.Text(v.Label, lx, ly)
+			r.Text(slice.Label, lx, ly)
 		}
-		total = total + v.Value
 	}
 }
 
+// drawOutsideLabels draws the labels in a column on each side of the pie with lines
+// to their slices, spreading the labels of small slices so they don't overlap.
+func (pc PieChart) drawOutsideLabels(r Renderer, canvasBox Box, slices []pieSlice, radius, extent float64) {
+	cx, _ := canvasBox.Center()
+
+	var left, right []pieLabel
+	for _, slice := range slices {
+		if len(slice.Label) == 0 {
+			continue
+		}
+		slice.style.GetTextOptions().WriteToRenderer(r)
+		label := pieLabel{slice: slice, text: r.MeasureText(slice.Label)}
+		label.anchorX, label.anchorY = slice.point(radius)
+		label.elbowX, label.y = slice.point(radius + DefaultPieLeaderLineLength)
+		if label.right = math.Cos(slice.mid()) >= 0; label.right {
+			right = append(right, label)
+		} else {
+			left = append(left, label)
+		}
+	}
+
+	rightX := cx + int(extent) + 2*DefaultPieLeaderLineLength
+	leftX := cx - int(extent) - 2*DefaultPieLeaderLineLength
+	for _, side := range [][]pieLabel{left, right} {
+		pieLabelsAvoidOverlap(side, canvasBox.Top, canvasBox.Bottom)
+		for _, label := range side {
+			lineX, textX := leftX, leftX-DefaultPieLabelSpacing-label.text.Width()
+			if label.right {
+				lineX, textX = rightX, rightX+DefaultPieLabelSpacing
+			}
+
+			pc.LeaderLineStyle.InheritFrom(Style{
+				StrokeColor: label.slice.style.FillColor,
+				StrokeWidth: 1,
+			}).WriteDrawingOptionsToRenderer(r)
+			r.MoveTo(label.anchorX, label.anchorY)
+			r.LineTo(label.elbowX, label.y)
+			r.LineTo(lineX, label.y)
+			r.Stroke()
+
+			label.slice.style.GetTextOptions().WriteToRenderer(r)
+			r.Text(label.slice.Label, textX, label.y+(label.text.Height()>>1))
+		}
+	}
+}
+
+// pieLabelsAvoidOverlap moves the labels of one side apart vertically so they don't overlap,
+// keeping them within top and bottom where possible.
+func pieLabelsAvoidOverlap(labels []pieLabel, top, bottom int) {
+	if len(labels) == 0 {
+		return
+	}
+	sort.SliceStable(labels, func(i, j int) bool {
+		return labels[i].y < labels[j].y
+	})
+
+	// push the labels down, then back up from the bottom.
+	last := len(labels) - 1
+	labels[0].y = util.Math.MaxInt(labels[0].y, top+(labels[0].text.Height()>>1))
+	for index := 1; index < len(labels); index++ {
+		minY := labels[index-1].y + ((labels[index-1].text.Height() + labels[index].text.Height()) >> 1) + DefaultPieLabelSpacing
+		labels[index].y = util.Math.MaxInt(labels[index].y, minY)
+	}
+	labels[last].y = util.Math.MinInt(labels[last].y, bottom-(labels[last].text.Height()>>1))
+	for index := last - 1; index >= 0; index-- {
+		maxY := labels[index+1].y - ((labels[index+1].text.Height() + labels[index].text.Height()) >> 1) - DefaultPieLabelSpacing
+		labels[index].y = util.Math.MinInt(labels[index].y, maxY)
+	}
+}
+
+// getExplode returns the explode offsets of the values kept by `finalizeValues`, and the largest offset.
+func (pc PieChart) getExplode() (explode []float64, max float64) {
+	for index, v := range pc.Values {
+		if v.Value <= 0 {
+			continue
+		}
+		var offset float64
+		if index < len(pc.Explode) && pc.Explode[index] > 0 {
+			offset = pc.Explode[index]
+		}
+		explode = append(explode, offset)
+		max = math.Max(max, offset)
+	}
+	return
+}
+
 func (pc PieChart) finalizeValues(values []Value) ([]Value, error) {
 	finalValues := Values(values).Normalize()
 	if len(finalValues) == 0 {
@@ -204,24 +446,24 @@ func (pc PieChart) getCanvasStyle() Style {
 }
 
 func (pc PieChart) styleDefaultsCanvas() Style {
-	return Style{
+	return pc.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   pc.GetColorPalette().CanvasColor(),
 		StrokeColor: pc.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultStrokeWidth,
-	}
+	})
 }
 
 func (pc PieChart) styleDefaultsPieChartValue() Style {
-	return Style{
+	return pc.GetTheme().SeriesStyle.InheritFrom(Style{
 		StrokeColor: pc.GetColorPalette().TextColor(),
 		StrokeWidth: 5.0,
 		FillColor:   pc.GetColorPalette().TextColor(),
-	}
+	})
 }
 
 func (pc PieChart) stylePieChartValue(index int) Style {
 	return pc.SliceStyle.InheritFrom(Style{
-		StrokeColor: ColorWhite,
+		StrokeColor: pc.GetColorPalette().BackgroundColor(),
 		StrokeWidth: 5.0,
 		FillColor:   pc.GetColorPalette().GetSeriesColor(index),
 		FontSize:    pc.getScaledFontSize(),
@@ -245,11 +487,28 @@ func (pc PieChart) getScaledFontSize() float64 {
 }
 
 func (pc PieChart) styleDefaultsBackground() Style {
-	return Style{
+	return pc.GetTheme().BackgroundStyle.InheritFrom(Style{
 		FillColor:   pc.GetColorPalette().BackgroundColor(),
 		StrokeColor: pc.GetColorPalette().BackgroundStrokeColor(),
 		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+// GetLegendEntries returns an entry for every labeled non-zero value.
+func (pc PieChart) GetLegendEntries() []LegendEntry {
+	var entries []LegendEntry
+	for index, v := range Values(pc.Values).Normalize() {
+		entries = append(entries, LegendEntry{
+			Label:  v.Label,
+			Style:  v.Style.InheritFrom(pc.stylePieChartValue(index)),
+			Marker: LegendMarkerBox,
+		})
 	}
+	return entries
+}
+
+func (pc PieChart) getLegend() chartLegend {
+	return newChartLegend(pc.Legend, pc, styleDefaultsLegend(pc.GetTheme(), pc.GetFont(), pc.GetColorPalette()))
 }
 
 func (pc PieChart) styleDefaultsElements() Style {
@@ -259,13 +518,24 @@ func (pc PieChart) styleDefaultsElements() Style {
 }
 
 func (pc PieChart) styleDefaultsTitle() Style {
-	return pc.TitleStyle.InheritFrom(Style{
+	return pc.TitleStyle.InheritFrom(pc.GetTheme().TitleStyle.InheritFrom(Style{
 		FontColor:           pc.GetColorPalette().TextColor(),
 		Font:                pc.GetFont(),
 		FontSize:            pc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
+	}))
+}
+
+func (pc PieChart) styleDefaultsCenterText() Style {
+	return pc.CenterTextStyle.InheritFrom(Style{
+		FontColor:           pc.GetColorPalette().TextColor(),
+		Font:                pc.GetFont(),
+		FontSize:            pc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignMiddle,
+		TextWrap:            TextWrapWord,
 	})
 }
 
@@ -288,17 +558,29 @@ func (pc PieChart) GetColorPalette() ColorPalette {
 	if pc.ColorPalette != nil {
 		return pc.ColorPalette
 	}
+	if theme := pc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return AlternateColorPalette
 }
 
+// GetTheme returns the theme of the chart or the default theme.
+func (pc PieChart) GetTheme() Theme {
+	if pc.Theme != nil {
+		return *pc.Theme
+	}
+	return DefaultTheme
+}
+
 // Box returns the chart bounds as a box.
 func (pc PieChart) Box() Box {
-	dpr := pc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
-	dpb := pc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+	padding := pc.GetTheme().BackgroundStyle.Padding
+	dpr := pc.Background.Padding.GetRight(padding.GetRight(DefaultBackgroundPadding.Right))
+	dpb := pc.Background.Padding.GetBottom(padding.GetBottom(DefaultBackgroundPadding.Bottom))
 
 	return Box{
-		Top:    pc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
-		Left:   pc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Top:    pc.Background.Padding.GetTop(padding.GetTop(DefaultBackgroundPadding.Top)),
+		Left:   pc.Background.Padding.GetLeft(padding.GetLeft(DefaultBackgroundPadding.Left)),
 		Right:  pc.GetWidth() - dpr,
 		Bottom: pc.GetHeight() - dpb,
 	}
@@ -2,6 +2,7 @@ package chart
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	assert "github.com/blend/go-sdk/assert"
@@ -67,3 +68,61 @@ func TestPieChartAllZeroValues(t *testing.T) {
 	err := pie.Render(PNG, b)
 	assert.NotNil(err)
 }
+
+func TestPieChartDonut(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := PieChart{
+		InnerRadius:   0.5,
+		CenterText:    "Total 30",
+		StartAngle:    -90,
+		Direction:     PieDirectionCounterClockwise,
+		Explode:       []float64{0, 0.1},
+		LabelPosition: PieLabelPositionOutside,
+		Values: []Value{
+			{Value: 20, Label: "Blue"},
+			{Value: 9, Label: "Green"},
+			{Value: 1, Label: "Gray"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	assert.Nil(pie.Render(PNG, b))
+	assert.NotZero(b.Len())
+
+	b.Reset()
+	assert.Nil(pie.Render(SVG, b))
+	assert.True(strings.Contains(b.String(), "Total 30"))
+}
+
+func TestPieChartGetExplode(t *testing.T) {
+	assert := assert.New(t)
+
+	pie := PieChart{
+		Values:  []Value{{Value: 1}, {Value: 0}, {Value: 1}, {Value: 1}},
+		Explode: []float64{0.1, 0.5, 0.2},
+	}
+
+	explode, max := pie.getExplode()
+	assert.Equal([]float64{0.1, 0.2, 0}, explode)
+	assert.Equal(0.2, max)
+}
+
+func TestPieLabelsAvoidOverlap(t *testing.T) {
+	assert := assert.New(t)
+
+	text := Box{Right: 20, Bottom: 10}
+	labels := []pieLabel{
+		{text: text, y: 95},
+		{text: text, y: 50},
+		{text: text, y: 96},
+		{text: text, y: 97},
+	}
+	pieLabelsAvoidOverlap(labels, 0, 100)
+
+	assert.Equal(50, labels[0].y)
+	for index := 1; index < len(labels); index++ {
+		assert.True(labels[index].y-labels[index-1].y >= text.Height())
+	}
+	assert.True(labels[len(labels)-1].y+(text.Height()>>1) <= 100)
+}
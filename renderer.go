@@ -84,3 +84,15 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// InteractiveRenderer is a renderer that can tag the elements it draws with the series and
+// data point they represent, e.g. as tooltips and data attributes in svg output.
+type InteractiveRenderer interface {
+	Renderer
+
+	// SetElementInfo sets the info attached to the elements drawn until it is cleared.
+	SetElementInfo(info ElementInfo)
+
+	// ClearElementInfo clears the element info.
+	ClearElementInfo()
+}
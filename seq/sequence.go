@@ -163,10 +163,10 @@ func (s Seq) Median() (median float64) {
 	sorted := s.Sort()
 	if l%2 == 0 {
 		v0 := sorted.GetValue(l/2 - 1)
-		v1 := sorted.GetValue(l/2 + 1)
+		v1 := sorted.GetValue(l / 2)
 		median = (v0 + v1) / 2
 	} else {
-		median = float64(sorted.GetValue(l << 1))
+		median = float64(sorted.GetValue(l >> 1))
 	}
 
 	return
@@ -233,13 +233,19 @@ func (s Seq) Percentile(percent float64) (percentile float64) {
 	sorted := s.Sort()
 	index := percent * float64(l)
 	if index == float64(int64(index)) {
+		// the percentile falls between two values; average them unless it is at either end.
 		i := f64i(index)
+		if i == 0 {
+			return sorted.GetValue(0)
+		}
+		if i == l {
+			return sorted.GetValue(l - 1)
+		}
 		ci := sorted.GetValue(i - 1)
 		c := sorted.GetValue(i)
 		percentile = (ci + c) / 2.0
 	} else {
-		i := f64i(index)
-		percentile = sorted.GetValue(i)
+		percentile = sorted.GetValue(int(index))
 	}
 
 	return percentile
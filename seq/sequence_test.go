@@ -82,6 +82,26 @@ func TestSequenceVariance(t *testing.T) {
 	assert.Equal(2, values.Variance())
 }
 
+func TestSequenceMedian(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(3, Values(5, 1, 3, 2, 4).Median())
+	assert.Equal(2.5, Values(4, 1, 3, 2).Median())
+	assert.Equal(7, Values(7).Median())
+}
+
+func TestSequencePercentile(t *testing.T) {
+	assert := assert.New(t)
+
+	values := Values(5, 1, 3, 2, 4)
+	assert.Equal(1, values.Percentile(0))
+	assert.Equal(2, values.Percentile(0.25))
+	assert.Equal(4, values.Percentile(0.75))
+	assert.Equal(5, values.Percentile(1.0))
+
+	assert.Equal(1.5, Values(4, 1, 3, 2).Percentile(0.25))
+}
+
 func TestSequenceNormalize(t *testing.T) {
 	assert := assert.New(t)
 
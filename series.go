@@ -8,3 +8,11 @@ type Series interface {
 	Validate() error
 	Render(r Renderer, canvasBox Box, xrange, yrange Range, s Style)
 }
+
+// ColorPaletteSeries is a series that colors its parts (e.g. the layers of a stacked area) from a palette.
+// Charts hand it their palette before drawing it.
+type ColorPaletteSeries interface {
+	Series
+	// WithDefaultColorPalette returns a copy of the series that uses the palette if it has none of its own.
+	WithDefaultColorPalette(cp ColorPalette) Series
+}
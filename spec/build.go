@@ -0,0 +1,390 @@
+package spec
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/drawing"
+)
+
+const (
+	// FormatterFloat formats values as floats, optionally with a printf `format`.
+	FormatterFloat = "float"
+	// FormatterPercent formats values as percentages.
+	FormatterPercent = "percent"
+	// FormatterTime formats values as times, optionally with a time layout `format`.
+	FormatterTime = "time"
+	// FormatterTimeHour formats values as hours.
+	FormatterTimeHour = "timeHour"
+	// FormatterTimeMinute formats values as minutes.
+	FormatterTimeMinute = "timeMinute"
+	// FormatterTimeDate formats values as dates.
+	FormatterTimeDate = "timeDate"
+)
+
+var (
+	// DotShapes are the dot shapes by their name in a spec.
+	DotShapes = map[string]chart.DotShape{
+		"circle":   chart.DotShapeCircle,
+		"square":   chart.DotShapeSquare,
+		"triangle": chart.DotShapeTriangle,
+		"diamond":  chart.DotShapeDiamond,
+		"cross":    chart.DotShapeCross,
+		"plus":     chart.DotShapePlus,
+		"star":     chart.DotShapeStar,
+	}
+
+	// Interpolations are the line interpolations by their name in a spec.
+	Interpolations = map[string]chart.Interpolation{
+		"linear":     chart.InterpolationLinear,
+		"monotone":   chart.InterpolationMonotoneCubic,
+		"catmullRom": chart.InterpolationCatmullRom,
+		"stepBefore": chart.InterpolationStepBefore,
+		"stepAfter":  chart.InterpolationStepAfter,
+		"stepMiddle": chart.InterpolationStepMiddle,
+	}
+)
+
+// GetTimeFormat returns the layout the series times are parsed with or a default.
+func (s Series) GetTimeFormat() string {
+	if s.TimeFormat == "" {
+		return time.RFC3339
+	}
+	return s.TimeFormat
+}
+
+// Build validates the spec and returns the chart it describes.
+func (c Chart) Build() (chart.Graph, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	theme := c.getTheme()
+	switch c.GetType() {
+	case TypeBar:
+		return chart.BarChart{
+			Title:       c.Title,
+			TitleStyle:  c.TitleStyle.toChart(c.Title != ""),
+			Theme:       theme,
+			Width:       c.Width,
+			Height:      c.Height,
+			DPI:         c.DPI,
+			Background:  c.Background.toChart(false),
+			Canvas:      c.Canvas.toChart(false),
+			XAxis:       c.XAxis.styleToChart(),
+			YAxis:       c.YAxis.toYAxis(chart.YAxisPrimary),
+			BarWidth:    c.BarWidth,
+			BarSpacing:  c.BarSpacing,
+			Orientation: c.getOrientation(),
+			Bars:        valuesToChart(c.Values),
+		}, nil
+	case TypeStackedBar:
+		bars := make([]chart.StackedBar, len(c.Stacks))
+		for index, sb := range c.Stacks {
+			bars[index] = chart.StackedBar{
+				Name:   sb.Name,
+				Width:  sb.Width,
+				Values: valuesToChart(sb.Values),
+			}
+		}
+		return chart.StackedBarChart{
+			Title:       c.Title,
+			TitleStyle:  c.TitleStyle.toChart(c.Title != ""),
+			Theme:       theme,
+			Width:       c.Width,
+			Height:      c.Height,
+			DPI:         c.DPI,
+			Background:  c.Background.toChart(false),
+			Canvas:      c.Canvas.toChart(false),
+			XAxis:       c.XAxis.styleToChart(),
+			YAxis:       c.YAxis.styleToChart(),
+			BarSpacing:  c.BarSpacing,
+			Orientation: c.getOrientation(),
+			Bars:        bars,
+		}, nil
+	case TypePie:
+		pie := chart.PieChart{
+			Title:      c.Title,
+			TitleStyle: c.TitleStyle.toChart(c.Title != ""),
+			Theme:      theme,
+			Width:      c.Width,
+			Height:     c.Height,
+			DPI:        c.DPI,
+			Background: c.Background.toChart(false),
+			Canvas:     c.Canvas.toChart(false),
+			Values:     valuesToChart(c.Values),
+		}
+		c.Pie.applyTo(&pie)
+		return pie, nil
+	case TypeBubble:
+		bubbles := make([]chart.BubbleValue, len(c.Bubbles))
+		for index, b := range c.Bubbles {
+			bubbles[index] = chart.BubbleValue{
+				Value: chart.Value{Label: b.Label, Value: b.Value, Style: b.Style.toChart(false)},
+				XVal:  b.X,
+				YVal:  b.Y,
+			}
+		}
+		return chart.BubbleChart{
+			Title:       c.Title,
+			TitleStyle:  c.TitleStyle.toChart(c.Title != ""),
+			Theme:       theme,
+			Width:       c.Width,
+			Height:      c.Height,
+			DPI:         c.DPI,
+			Background:  c.Background.toChart(false),
+			Canvas:      c.Canvas.toChart(false),
+			BubbleScale: c.BubbleScale,
+			XAxis:       c.XAxis.toXAxis(),
+			YAxis:       c.YAxis.toYAxis(chart.YAxisPrimary),
+			Bubbles:     bubbles,
+		}, nil
+	}
+
+	series := make([]chart.Series, len(c.Series))
+	for index, s := range c.Series {
+		series[index] = s.toChart()
+	}
+	return chart.Chart{
+		Title:          c.Title,
+		TitleStyle:     c.TitleStyle.toChart(c.Title != ""),
+		Theme:          theme,
+		Width:          c.Width,
+		Height:         c.Height,
+		DPI:            c.DPI,
+		Background:     c.Background.toChart(false),
+		Canvas:         c.Canvas.toChart(false),
+		XAxis:          c.XAxis.toXAxis(),
+		YAxis:          c.YAxis.toYAxis(chart.YAxisPrimary),
+		YAxisSecondary: c.YAxisSecondary.toYAxis(chart.YAxisSecondary),
+		Series:         series,
+	}, nil
+}
+
+func (c Chart) getTheme() *chart.Theme {
+	switch c.Theme {
+	case ThemeLight:
+		return &chart.ThemeLight
+	case ThemeDark:
+		return &chart.ThemeDark
+	case ThemeHighContrast:
+		return &chart.ThemeHighContrast
+	case ThemePrint:
+		return &chart.ThemePrint
+	}
+	return nil
+}
+
+func (c Chart) getOrientation() chart.BarOrientation {
+	if c.Orientation == OrientationHorizontal {
+		return chart.BarOrientationHorizontal
+	}
+	return chart.BarOrientationVertical
+}
+
+func (p *Pie) applyTo(pie *chart.PieChart) {
+	if p == nil {
+		return
+	}
+	pie.InnerRadius = p.InnerRadius
+	pie.CenterText = p.CenterText
+	pie.StartAngle = p.StartAngle
+	pie.Explode = p.Explode
+	if p.Direction == DirectionCounterClockwise {
+		pie.Direction = chart.PieDirectionCounterClockwise
+	}
+	if p.LabelPosition == LabelPositionOutside {
+		pie.LabelPosition = chart.PieLabelPositionOutside
+	}
+}
+
+func (s Series) toChart() chart.Series {
+	yaxis := chart.YAxisPrimary
+	if s.YAxis == "secondary" {
+		yaxis = chart.YAxisSecondary
+	}
+
+	if s.GetType() == SeriesTime {
+		times := make([]time.Time, len(s.Times))
+		for index, t := range s.Times {
+			times[index], _ = time.Parse(s.GetTimeFormat(), t)
+		}
+		return chart.TimeSeries{
+			Name:    s.Name,
+			Style:   s.Style.toChart(false),
+			YAxis:   yaxis,
+			XValues: times,
+			YValues: s.YValues,
+		}
+	}
+	return chart.ContinuousSeries{
+		Name:    s.Name,
+		Style:   s.Style.toChart(false),
+		YAxis:   yaxis,
+		XValues: s.XValues,
+		YValues: s.YValues,
+	}
+}
+
+func valuesToChart(values []Value) []chart.Value {
+	output := make([]chart.Value, len(values))
+	for index, v := range values {
+		output[index] = chart.Value{
+			Label: v.Label,
+			Value: v.Value,
+			Style: v.Style.toChart(false),
+		}
+	}
+	return output
+}
+
+// styleToChart returns the style of an axis that is only described by a style.
+// An axis that is present in the spec is shown unless its style says otherwise.
+func (a *Axis) styleToChart() chart.Style {
+	if a == nil {
+		return chart.Style{}
+	}
+	return a.Style.toChart(true)
+}
+
+func (a *Axis) toXAxis() chart.XAxis {
+	if a == nil {
+		return chart.XAxis{}
+	}
+	return chart.XAxis{
+		Name:           a.Name,
+		NameStyle:      a.NameStyle.toChart(a.Name != ""),
+		Style:          a.Style.toChart(true),
+		ValueFormatter: a.getValueFormatter(),
+		Range:          a.getRange(),
+		Ticks:          a.getTicks(),
+		GridMajorStyle: a.GridMajorStyle.toChart(a.GridMajorStyle != nil),
+		GridMinorStyle: a.GridMinorStyle.toChart(a.GridMinorStyle != nil),
+	}
+}
+
+func (a *Axis) toYAxis(axisType chart.YAxisType) chart.YAxis {
+	if a == nil {
+		return chart.YAxis{AxisType: axisType}
+	}
+	return chart.YAxis{
+		Name:           a.Name,
+		NameStyle:      a.NameStyle.toChart(a.Name != ""),
+		Style:          a.Style.toChart(true),
+		AxisType:       axisType,
+		ValueFormatter: a.getValueFormatter(),
+		Range:          a.getRange(),
+		Ticks:          a.getTicks(),
+		GridMajorStyle: a.GridMajorStyle.toChart(a.GridMajorStyle != nil),
+		GridMinorStyle: a.GridMinorStyle.toChart(a.GridMinorStyle != nil),
+	}
+}
+
+func (a *Axis) getValueFormatter() chart.ValueFormatter {
+	switch a.Formatter {
+	case FormatterFloat:
+		if a.Format != "" {
+			format := a.Format
+			return func(v interface{}) string {
+				return chart.FloatValueFormatterWithFormat(v, format)
+			}
+		}
+		return chart.FloatValueFormatter
+	case FormatterPercent:
+		return chart.PercentValueFormatter
+	case FormatterTime:
+		if a.Format != "" {
+			return chart.TimeValueFormatterWithFormat(a.Format)
+		}
+		return chart.TimeValueFormatter
+	case FormatterTimeHour:
+		return chart.TimeHourValueFormatter
+	case FormatterTimeMinute:
+		return chart.TimeMinuteValueFormatter
+	case FormatterTimeDate:
+		return chart.TimeDateValueFormatter
+	}
+	return nil
+}
+
+func (a *Axis) getRange() chart.Range {
+	if a.Range == nil {
+		return nil
+	}
+	if a.Range.Logarithmic {
+		return &chart.LogarithmicRange{
+			Base:       a.Range.Base,
+			Min:        a.Range.Min,
+			Max:        a.Range.Max,
+			Descending: a.Range.Descending,
+		}
+	}
+	return &chart.ContinuousRange{
+		Min:        a.Range.Min,
+		Max:        a.Range.Max,
+		Descending: a.Range.Descending,
+	}
+}
+
+func (a *Axis) getTicks() []chart.Tick {
+	if len(a.Ticks) == 0 {
+		return nil
+	}
+	ticks := make([]chart.Tick, len(a.Ticks))
+	for index, t := range a.Ticks {
+		ticks[index] = chart.Tick{Value: t.Value, Label: t.Label}
+	}
+	return ticks
+}
+
+// toChart returns the chart style, `show` is used if the spec doesn't set it.
+func (s *Style) toChart(show bool) chart.Style {
+	if s == nil {
+		return chart.Style{Show: show}
+	}
+	output := chart.Style{
+		Show:            show,
+		StrokeWidth:     s.StrokeWidth,
+		StrokeDashArray: s.StrokeDashArray,
+		DotWidth:        s.DotWidth,
+		FontSize:        s.FontSize,
+	}
+	if s.Show != nil {
+		output.Show = *s.Show
+	}
+	output.StrokeColor, _ = parseColor(s.StrokeColor)
+	output.FillColor, _ = parseColor(s.FillColor)
+	output.DotColor, _ = parseColor(s.DotColor)
+	output.DotShape = DotShapes[s.DotShape]
+	output.Interpolation = Interpolations[s.Interpolation]
+	output.FontColor, _ = parseColor(s.FontColor)
+	if s.Padding != nil {
+		output.Padding = chart.Box{
+			Top:    s.Padding.Top,
+			Left:   s.Padding.Left,
+			Right:  s.Padding.Right,
+			Bottom: s.Padding.Bottom,
+		}
+	}
+	return output
+}
+
+// parseColor parses a css hex color with an optional leading `#` and an optional alpha byte.
+func parseColor(value string) (drawing.Color, bool) {
+	hex := strings.TrimPrefix(value, "#")
+	switch len(hex) {
+	case 3, 6, 8:
+	default:
+		return drawing.Color{}, false
+	}
+	if _, err := strconv.ParseUint(hex, 16, 32); err != nil {
+		return drawing.Color{}, false
+	}
+	if len(hex) == 8 {
+		alpha, _ := strconv.ParseUint(hex[6:], 16, 8)
+		return drawing.ColorFromHex(hex[:6]).WithAlpha(uint8(alpha)), true
+	}
+	return drawing.ColorFromHex(hex), true
+}
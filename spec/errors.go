@@ -0,0 +1,59 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError is a validation error of a single spec field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error implements error.
+func (fe FieldError) Error() string {
+	if fe.Field == "" {
+		return fe.Message
+	}
+	return fmt.Sprintf("%s: %s", fe.Field, fe.Message)
+}
+
+// FieldErrors are the validation errors of a spec.
+type FieldErrors []FieldError
+
+// Error implements error.
+func (fe FieldErrors) Error() string {
+	messages := make([]string, len(fe))
+	for index, err := range fe {
+		messages[index] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// errorCollector gathers field errors while walking a spec.
+type errorCollector struct {
+	errors FieldErrors
+}
+
+func (ec *errorCollector) add(field, format string, args ...interface{}) {
+	ec.errors = append(ec.errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (ec *errorCollector) err() error {
+	if len(ec.errors) == 0 {
+		return nil
+	}
+	return ec.errors
+}
+
+func fieldPath(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
+
+func indexPath(parent string, index int) string {
+	return fmt.Sprintf("%s[%d]", parent, index)
+}
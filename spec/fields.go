@@ -0,0 +1,83 @@
+package spec
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// checkUnknownFields reports the keys of a generically decoded document that no field of the spec type has.
+// Unmarshalers like `yaml.Unmarshal` silently drop those keys when decoding into the spec types.
+func checkUnknownFields(ec *errorCollector, path string, document interface{}, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		keys := documentKeys(document)
+		if keys == nil {
+			return
+		}
+		names := sortedKeys(keys)
+		for _, name := range names {
+			field, ok := specField(t, name)
+			if !ok {
+				ec.add(fieldPath(path, name), "unknown field")
+				continue
+			}
+			checkUnknownFields(ec, fieldPath(path, name), keys[name], field.Type)
+		}
+	case reflect.Slice:
+		if items, ok := document.([]interface{}); ok {
+			for index, item := range items {
+				checkUnknownFields(ec, indexPath(path, index), item, t.Elem())
+			}
+		}
+	}
+}
+
+// documentKeys returns the entries of a decoded object, which json decodes
+// with string keys and some yaml packages with interface{} keys.
+func documentKeys(document interface{}) map[string]interface{} {
+	switch typed := document.(type) {
+	case map[string]interface{}:
+		return typed
+	case map[interface{}]interface{}:
+		keys := make(map[string]interface{}, len(typed))
+		for key, value := range typed {
+			keys[fmt.Sprint(key)] = value
+		}
+		return keys
+	}
+	return nil
+}
+
+func sortedKeys(keys map[string]interface{}) []string {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// specField finds the field of a spec type by its yaml (or json) name.
+func specField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		tag, ok := field.Tag.Lookup("yaml")
+		if !ok {
+			tag = field.Tag.Get("json")
+		}
+		tagName := strings.Split(tag, ",")[0]
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
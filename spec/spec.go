@@ -0,0 +1,262 @@
+// Package spec describes charts declaratively so they can be stored as JSON or YAML
+// documents and turned into the chart types of the chart package.
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+
+	chart "github.com/daill/go-chart"
+)
+
+const (
+	// TypeLine is a line chart built from continuous or time series.
+	TypeLine = "line"
+	// TypeBar is a bar chart.
+	TypeBar = "bar"
+	// TypeStackedBar is a stacked bar chart.
+	TypeStackedBar = "stackedBar"
+	// TypePie is a pie chart.
+	TypePie = "pie"
+	// TypeBubble is a bubble chart.
+	TypeBubble = "bubble"
+)
+
+const (
+	// SeriesContinuous is a series with float x values.
+	SeriesContinuous = "continuous"
+	// SeriesTime is a series with time x values.
+	SeriesTime = "time"
+)
+
+const (
+	// OrientationVertical draws bars bottom-to-top.
+	OrientationVertical = "vertical"
+	// OrientationHorizontal draws bars left-to-right.
+	OrientationHorizontal = "horizontal"
+)
+
+const (
+	// DirectionClockwise draws pie slices clockwise.
+	DirectionClockwise = "clockwise"
+	// DirectionCounterClockwise draws pie slices counter clockwise.
+	DirectionCounterClockwise = "counterClockwise"
+)
+
+const (
+	// LabelPositionInside draws pie labels inside the slices.
+	LabelPositionInside = "inside"
+	// LabelPositionOutside draws pie labels outside the pie with leader lines.
+	LabelPositionOutside = "outside"
+)
+
+const (
+	// ThemeLight is dark text and axes on white.
+	ThemeLight = "light"
+	// ThemeDark is light text and axes on a dark background.
+	ThemeDark = "dark"
+	// ThemeHighContrast is black on white with saturated colors, thicker lines and larger text.
+	ThemeHighContrast = "highContrast"
+	// ThemePrint is black on white with gray series colors.
+	ThemePrint = "print"
+)
+
+// Unmarshaler is a function that decodes a document into a value, e.g. `yaml.Unmarshal`.
+type Unmarshaler func(data []byte, v interface{}) error
+
+// Parse decodes a json chart spec and validates it.
+func Parse(data []byte) (*Chart, error) {
+	var c Chart
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ParseWith decodes a chart spec with a given unmarshaler and validates it.
+// Keys that are not part of the spec are rejected like they are by Parse,
+// so a misspelled yaml key is an error rather than silently ignored.
+func ParseWith(data []byte, unmarshal Unmarshaler) (*Chart, error) {
+	var document interface{}
+	if err := unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	var ec errorCollector
+	checkUnknownFields(&ec, "", document, reflect.TypeOf(Chart{}))
+	if err := ec.err(); err != nil {
+		return nil, err
+	}
+
+	var c Chart
+	if err := unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Chart is the document root of a chart spec.
+type Chart struct {
+	Type       string `json:"type,omitempty" yaml:"type,omitempty"`
+	Title      string `json:"title,omitempty" yaml:"title,omitempty"`
+	TitleStyle *Style `json:"titleStyle,omitempty" yaml:"titleStyle,omitempty"`
+	Theme      string `json:"theme,omitempty" yaml:"theme,omitempty"`
+
+	Width  int     `json:"width,omitempty" yaml:"width,omitempty"`
+	Height int     `json:"height,omitempty" yaml:"height,omitempty"`
+	DPI    float64 `json:"dpi,omitempty" yaml:"dpi,omitempty"`
+
+	Background *Style `json:"background,omitempty" yaml:"background,omitempty"`
+	Canvas     *Style `json:"canvas,omitempty" yaml:"canvas,omitempty"`
+
+	XAxis          *Axis `json:"xAxis,omitempty" yaml:"xAxis,omitempty"`
+	YAxis          *Axis `json:"yAxis,omitempty" yaml:"yAxis,omitempty"`
+	YAxisSecondary *Axis `json:"yAxisSecondary,omitempty" yaml:"yAxisSecondary,omitempty"`
+
+	Orientation string  `json:"orientation,omitempty" yaml:"orientation,omitempty"`
+	BarWidth    int     `json:"barWidth,omitempty" yaml:"barWidth,omitempty"`
+	BarSpacing  int     `json:"barSpacing,omitempty" yaml:"barSpacing,omitempty"`
+	BubbleScale float64 `json:"bubbleScale,omitempty" yaml:"bubbleScale,omitempty"`
+	Pie         *Pie    `json:"pie,omitempty" yaml:"pie,omitempty"`
+
+	Series  []Series     `json:"series,omitempty" yaml:"series,omitempty"`
+	Values  []Value      `json:"values,omitempty" yaml:"values,omitempty"`
+	Stacks  []StackedBar `json:"stacks,omitempty" yaml:"stacks,omitempty"`
+	Bubbles []Bubble     `json:"bubbles,omitempty" yaml:"bubbles,omitempty"`
+}
+
+// GetType returns the chart type or a default.
+func (c Chart) GetType() string {
+	if c.Type == "" {
+		return TypeLine
+	}
+	return c.Type
+}
+
+// Render builds the chart and renders it.
+func (c Chart) Render(rp chart.RendererProvider, w io.Writer) error {
+	graph, err := c.Build()
+	if err != nil {
+		return err
+	}
+	return graph.Render(rp, w)
+}
+
+// Series is a line chart series.
+type Series struct {
+	Name       string    `json:"name,omitempty" yaml:"name,omitempty"`
+	Type       string    `json:"type,omitempty" yaml:"type,omitempty"`
+	YAxis      string    `json:"yAxis,omitempty" yaml:"yAxis,omitempty"`
+	XValues    []float64 `json:"xValues,omitempty" yaml:"xValues,omitempty"`
+	Times      []string  `json:"times,omitempty" yaml:"times,omitempty"`
+	TimeFormat string    `json:"timeFormat,omitempty" yaml:"timeFormat,omitempty"`
+	YValues    []float64 `json:"yValues,omitempty" yaml:"yValues,omitempty"`
+	Style      *Style    `json:"style,omitempty" yaml:"style,omitempty"`
+}
+
+// GetType returns the series type or a default.
+func (s Series) GetType() string {
+	if s.Type == "" {
+		if len(s.Times) > 0 {
+			return SeriesTime
+		}
+		return SeriesContinuous
+	}
+	return s.Type
+}
+
+// Pie are the options of a pie chart.
+type Pie struct {
+	InnerRadius   float64   `json:"innerRadius,omitempty" yaml:"innerRadius,omitempty"`
+	CenterText    string    `json:"centerText,omitempty" yaml:"centerText,omitempty"`
+	StartAngle    float64   `json:"startAngle,omitempty" yaml:"startAngle,omitempty"`
+	Direction     string    `json:"direction,omitempty" yaml:"direction,omitempty"`
+	Explode       []float64 `json:"explode,omitempty" yaml:"explode,omitempty"`
+	LabelPosition string    `json:"labelPosition,omitempty" yaml:"labelPosition,omitempty"`
+}
+
+// Value is a labeled value of a bar, stacked bar or pie chart.
+type Value struct {
+	Label string  `json:"label,omitempty" yaml:"label,omitempty"`
+	Value float64 `json:"value" yaml:"value"`
+	Style *Style  `json:"style,omitempty" yaml:"style,omitempty"`
+}
+
+// StackedBar is a bar of a stacked bar chart.
+type StackedBar struct {
+	Name   string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Width  int     `json:"width,omitempty" yaml:"width,omitempty"`
+	Values []Value `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+// Bubble is a value of a bubble chart.
+type Bubble struct {
+	Label string  `json:"label,omitempty" yaml:"label,omitempty"`
+	X     float64 `json:"x" yaml:"x"`
+	Y     float64 `json:"y" yaml:"y"`
+	Value float64 `json:"value" yaml:"value"`
+	Style *Style  `json:"style,omitempty" yaml:"style,omitempty"`
+}
+
+// Axis describes an axis.
+type Axis struct {
+	Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+	NameStyle *Style `json:"nameStyle,omitempty" yaml:"nameStyle,omitempty"`
+	Style     *Style `json:"style,omitempty" yaml:"style,omitempty"`
+
+	Range     *Range `json:"range,omitempty" yaml:"range,omitempty"`
+	Formatter string `json:"formatter,omitempty" yaml:"formatter,omitempty"`
+	Format    string `json:"format,omitempty" yaml:"format,omitempty"`
+	Ticks     []Tick `json:"ticks,omitempty" yaml:"ticks,omitempty"`
+
+	GridMajorStyle *Style `json:"gridMajorStyle,omitempty" yaml:"gridMajorStyle,omitempty"`
+	GridMinorStyle *Style `json:"gridMinorStyle,omitempty" yaml:"gridMinorStyle,omitempty"`
+}
+
+// Range fixes the bounds of an axis.
+type Range struct {
+	Min         float64 `json:"min" yaml:"min"`
+	Max         float64 `json:"max" yaml:"max"`
+	Descending  bool    `json:"descending,omitempty" yaml:"descending,omitempty"`
+	Logarithmic bool    `json:"logarithmic,omitempty" yaml:"logarithmic,omitempty"`
+	Base        float64 `json:"base,omitempty" yaml:"base,omitempty"`
+}
+
+// Tick is a fixed axis tick.
+type Tick struct {
+	Value float64 `json:"value" yaml:"value"`
+	Label string  `json:"label,omitempty" yaml:"label,omitempty"`
+}
+
+// Style describes the style of a chart element. Colors are css hex codes.
+type Style struct {
+	Show            *bool     `json:"show,omitempty" yaml:"show,omitempty"`
+	StrokeColor     string    `json:"strokeColor,omitempty" yaml:"strokeColor,omitempty"`
+	StrokeWidth     float64   `json:"strokeWidth,omitempty" yaml:"strokeWidth,omitempty"`
+	StrokeDashArray []float64 `json:"strokeDashArray,omitempty" yaml:"strokeDashArray,omitempty"`
+	FillColor       string    `json:"fillColor,omitempty" yaml:"fillColor,omitempty"`
+	DotColor        string    `json:"dotColor,omitempty" yaml:"dotColor,omitempty"`
+	DotWidth        float64   `json:"dotWidth,omitempty" yaml:"dotWidth,omitempty"`
+	DotShape        string    `json:"dotShape,omitempty" yaml:"dotShape,omitempty"`
+	Interpolation   string    `json:"interpolation,omitempty" yaml:"interpolation,omitempty"`
+	FontColor       string    `json:"fontColor,omitempty" yaml:"fontColor,omitempty"`
+	FontSize        float64   `json:"fontSize,omitempty" yaml:"fontSize,omitempty"`
+	Padding         *Padding  `json:"padding,omitempty" yaml:"padding,omitempty"`
+}
+
+// Padding is the padding of a styled element.
+type Padding struct {
+	Top    int `json:"top,omitempty" yaml:"top,omitempty"`
+	Left   int `json:"left,omitempty" yaml:"left,omitempty"`
+	Right  int `json:"right,omitempty" yaml:"right,omitempty"`
+	Bottom int `json:"bottom,omitempty" yaml:"bottom,omitempty"`
+}
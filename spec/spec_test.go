@@ -0,0 +1,235 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	chart "github.com/daill/go-chart"
+	"github.com/daill/go-chart/drawing"
+)
+
+const testLineSpec = `{
+	"title": "Test",
+	"width": 320,
+	"height": 240,
+	"xAxis": {"name": "x", "formatter": "float", "format": "%.1f"},
+	"yAxis": {"range": {"min": 0, "max": 10}, "gridMajorStyle": {"strokeColor": "#ccc"}},
+	"series": [
+		{"name": "a", "xValues": [1, 2, 3], "yValues": [1, 4, 9], "style": {"strokeColor": "#ff0000", "strokeWidth": 2, "dotShape": "diamond", "interpolation": "stepAfter"}},
+		{"name": "b", "times": ["2020-01-01T00:00:00Z", "2020-01-02T00:00:00Z"], "yValues": [1, 2], "yAxis": "secondary"}
+	]
+}`
+
+func TestParseLine(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := Parse([]byte(testLineSpec))
+	assert.Nil(err)
+
+	graph, err := c.Build()
+	assert.Nil(err)
+
+	typed, isTyped := graph.(chart.Chart)
+	assert.True(isTyped)
+	assert.Equal("Test", typed.Title)
+	assert.True(typed.TitleStyle.Show)
+	assert.True(typed.XAxis.Style.Show)
+	assert.Equal("2.0", typed.XAxis.ValueFormatter(2.0))
+	assert.False(typed.YAxisSecondary.Style.Show)
+	assert.True(typed.YAxis.GridMajorStyle.Show)
+	assert.Equal(10.0, typed.YAxis.Range.GetMax())
+	assert.Len(2, typed.Series)
+
+	continuous, isContinuous := typed.Series[0].(chart.ContinuousSeries)
+	assert.True(isContinuous)
+	assert.Equal(drawing.ColorRed, continuous.Style.StrokeColor)
+	assert.Equal(2.0, continuous.Style.StrokeWidth)
+	assert.NotNil(continuous.Style.DotShape)
+	assert.Equal(chart.InterpolationStepAfter, continuous.Style.Interpolation)
+
+	ts, isTimeSeries := typed.Series[1].(chart.TimeSeries)
+	assert.True(isTimeSeries)
+	assert.Equal(chart.YAxisSecondary, ts.YAxis)
+	assert.Equal(2, ts.XValues[1].Day())
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(chart.SVG, buffer))
+	assert.True(strings.HasPrefix(buffer.String(), "<svg"))
+}
+
+func TestParseWith(t *testing.T) {
+	assert := assert.New(t)
+
+	var unmarshaled bool
+	c, err := ParseWith([]byte(`{"type": "pie", "values": [{"label": "a", "value": 1}, {"label": "b", "value": 2}]}`), func(data []byte, v interface{}) error {
+		unmarshaled = true
+		return json.Unmarshal(data, v)
+	})
+	assert.Nil(err)
+	assert.True(unmarshaled)
+
+	graph, err := c.Build()
+	assert.Nil(err)
+	pie, isPie := graph.(chart.PieChart)
+	assert.True(isPie)
+	assert.Len(2, pie.Values)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(chart.PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestParsePieOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := Parse([]byte(`{"type": "pie", "pie": {"innerRadius": 0.5, "centerText": "3", "startAngle": -90, "direction": "counterClockwise", "explode": [0, 0.1], "labelPosition": "outside"}, "values": [{"label": "a", "value": 1}, {"label": "b", "value": 2}]}`))
+	assert.Nil(err)
+	graph, err := c.Build()
+	assert.Nil(err)
+	pie, isPie := graph.(chart.PieChart)
+	assert.True(isPie)
+	assert.Equal(0.5, pie.InnerRadius)
+	assert.Equal(-90.0, pie.StartAngle)
+	assert.Equal(chart.PieDirectionCounterClockwise, pie.Direction)
+	assert.Equal(chart.PieLabelPositionOutside, pie.LabelPosition)
+
+	_, err = Parse([]byte(`{"type": "pie", "pie": {"innerRadius": 1, "direction": "up", "explode": [-1]}, "values": [{"value": 1}]}`))
+	assert.NotNil(err)
+	assert.Len(3, err.(FieldErrors))
+	assert.True(strings.Contains(err.Error(), "pie.explode[0]: must not be negative"))
+}
+
+func TestParseTheme(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := Parse([]byte(`{"type": "bar", "theme": "dark", "values": [{"label": "a", "value": 1}]}`))
+	assert.Nil(err)
+	graph, err := c.Build()
+	assert.Nil(err)
+	bar, isBar := graph.(chart.BarChart)
+	assert.True(isBar)
+	assert.Equal(chart.ThemeDark.ColorPalette.BackgroundColor(), bar.GetColorPalette().BackgroundColor())
+
+	_, err = Parse([]byte(`{"theme": "neon", "series": [{"xValues": [1, 2], "yValues": [1, 2]}]}`))
+	assert.NotNil(err)
+	assert.True(strings.Contains(err.Error(), "theme: must be one of"))
+}
+
+func TestParseBarCharts(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := Parse([]byte(`{"type": "bar", "orientation": "horizontal", "yAxis": {}, "values": [{"label": "a", "value": 1}, {"label": "b", "value": 2}]}`))
+	assert.Nil(err)
+	graph, err := c.Build()
+	assert.Nil(err)
+	bar, isBar := graph.(chart.BarChart)
+	assert.True(isBar)
+	assert.Equal(chart.BarOrientationHorizontal, bar.Orientation)
+	assert.True(bar.YAxis.Style.Show)
+	assert.False(bar.XAxis.Show)
+
+	c, err = Parse([]byte(`{"type": "stackedBar", "stacks": [{"name": "a", "values": [{"value": 1}, {"value": 2}]}]}`))
+	assert.Nil(err)
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(chart.PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestParseUnknownField(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Parse([]byte(`{"series": [{"yValues": [1], "xValues": [1], "color": "#fff"}]}`))
+	assert.NotNil(err)
+	assert.True(strings.Contains(err.Error(), "color"))
+}
+
+func TestParseWithUnknownField(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := ParseWith([]byte(`{"series": [{"xValues": [1, 2], "yValue": [1, 2]}], "yAxis": {"range": {"mx": 1}}}`), json.Unmarshal)
+	assert.NotNil(err)
+	fieldErrors, isFieldErrors := err.(FieldErrors)
+	assert.True(isFieldErrors)
+	assert.Len(2, fieldErrors)
+	assert.Equal("series[0].yValue", fieldErrors[0].Field)
+	assert.Equal("yAxis.range.mx", fieldErrors[1].Field)
+
+	// yaml decoders that produce maps with interface{} keys are checked as well.
+	var ec errorCollector
+	checkUnknownFields(&ec, "", map[interface{}]interface{}{
+		"type":  "pie",
+		"pie":   map[interface{}]interface{}{"centreText": "x"},
+		"value": []interface{}{},
+	}, reflect.TypeOf(Chart{}))
+	assert.Len(2, ec.errors)
+	assert.Equal("pie.centreText", ec.errors[0].Field)
+	assert.Equal("value", ec.errors[1].Field)
+}
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Parse([]byte(`{
+		"xAxis": {"range": {"min": 5, "max": 1}, "formatter": "bogus"},
+		"series": [
+			{"xValues": [1, 2], "yValues": [1, 2]},
+			{"xValues": [1], "yValues": [1, 2], "style": {"strokeColor": "#12345g", "dotShape": "blob"}},
+			{"times": ["yesterday"], "yValues": [1]}
+		]
+	}`))
+	assert.NotNil(err)
+
+	fieldErrors, isFieldErrors := err.(FieldErrors)
+	assert.True(isFieldErrors)
+
+	var fields []string
+	for _, fe := range fieldErrors {
+		fields = append(fields, fe.Field)
+	}
+	assert.Equal([]string{
+		"xAxis.range.max",
+		"xAxis.formatter",
+		"series[1].xValues",
+		"series[1].style.strokeColor",
+		"series[1].style.dotShape",
+		"series[2].times[0]",
+	}, fields)
+	assert.True(strings.Contains(err.Error(), "series[1].style.strokeColor: \"#12345g\" is not a hex color"))
+}
+
+func TestValidateChartType(t *testing.T) {
+	assert := assert.New(t)
+
+	err := Chart{Type: "radar"}.Validate()
+	assert.NotNil(err)
+	assert.Equal("type: unknown chart type \"radar\"", err.Error())
+
+	err = Chart{Type: TypeBar}.Validate()
+	assert.NotNil(err)
+	assert.Equal("values: must have at least one value", err.Error())
+}
+
+func TestParseColor(t *testing.T) {
+	assert := assert.New(t)
+
+	c, ok := parseColor("#fff")
+	assert.True(ok)
+	assert.Equal(drawing.ColorWhite, c)
+
+	c, ok = parseColor("0000ff")
+	assert.True(ok)
+	assert.Equal(drawing.ColorBlue, c)
+
+	c, ok = parseColor("#ff000080")
+	assert.True(ok)
+	assert.Equal(drawing.ColorRed.WithAlpha(128), c)
+
+	_, ok = parseColor("#ff00")
+	assert.False(ok)
+	_, ok = parseColor("red")
+	assert.False(ok)
+}
@@ -0,0 +1,245 @@
+package spec
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Validate validates the spec, returning FieldErrors that point at every offending field.
+func (c Chart) Validate() error {
+	ec := &errorCollector{}
+
+	if c.Width < 0 {
+		ec.add("width", "must not be negative")
+	}
+	if c.Height < 0 {
+		ec.add("height", "must not be negative")
+	}
+	if c.DPI < 0 {
+		ec.add("dpi", "must not be negative")
+	}
+
+	validateStyle(ec, "titleStyle", c.TitleStyle)
+	validateStyle(ec, "background", c.Background)
+	validateStyle(ec, "canvas", c.Canvas)
+	validateAxis(ec, "xAxis", c.XAxis)
+	validateAxis(ec, "yAxis", c.YAxis)
+	validateAxis(ec, "yAxisSecondary", c.YAxisSecondary)
+
+	switch c.Theme {
+	case "", ThemeLight, ThemeDark, ThemeHighContrast, ThemePrint:
+	default:
+		ec.add("theme", "must be one of %q, %q, %q or %q", ThemeLight, ThemeDark, ThemeHighContrast, ThemePrint)
+	}
+
+	switch c.Orientation {
+	case "", OrientationVertical, OrientationHorizontal:
+	default:
+		ec.add("orientation", "must be one of %q or %q", OrientationVertical, OrientationHorizontal)
+	}
+
+	switch c.GetType() {
+	case TypeLine:
+		if len(c.Series) == 0 {
+			ec.add("series", "must have at least one series")
+		}
+		for index, s := range c.Series {
+			validateSeries(ec, indexPath("series", index), s)
+		}
+	case TypeBar, TypePie:
+		if len(c.Values) == 0 {
+			ec.add("values", "must have at least one value")
+		}
+		validateValues(ec, "values", c.Values, c.GetType() == TypePie)
+		validatePie(ec, "pie", c.Pie)
+	case TypeStackedBar:
+		if len(c.Stacks) == 0 {
+			ec.add("stacks", "must have at least one stacked bar")
+		}
+		for index, sb := range c.Stacks {
+			path := indexPath("stacks", index)
+			if sb.Width < 0 {
+				ec.add(fieldPath(path, "width"), "must not be negative")
+			}
+			if len(sb.Values) == 0 {
+				ec.add(fieldPath(path, "values"), "must have at least one value")
+			}
+			validateValues(ec, fieldPath(path, "values"), sb.Values, true)
+		}
+	case TypeBubble:
+		if len(c.Bubbles) == 0 {
+			ec.add("bubbles", "must have at least one bubble")
+		}
+		for index, b := range c.Bubbles {
+			path := indexPath("bubbles", index)
+			if b.Value < 0 {
+				ec.add(fieldPath(path, "value"), "must not be negative")
+			}
+			validateStyle(ec, fieldPath(path, "style"), b.Style)
+		}
+	default:
+		ec.add("type", "unknown chart type %q", c.Type)
+	}
+
+	return ec.err()
+}
+
+func validateSeries(ec *errorCollector, path string, s Series) {
+	switch s.YAxis {
+	case "", "primary", "secondary":
+	default:
+		ec.add(fieldPath(path, "yAxis"), "must be one of \"primary\" or \"secondary\"")
+	}
+
+	if len(s.YValues) == 0 {
+		ec.add(fieldPath(path, "yValues"), "must have at least one value")
+	}
+
+	switch s.GetType() {
+	case SeriesContinuous:
+		if len(s.Times) > 0 {
+			ec.add(fieldPath(path, "times"), "is only valid for %q series", SeriesTime)
+		}
+		if len(s.XValues) != len(s.YValues) {
+			ec.add(fieldPath(path, "xValues"), "has %d values but yValues has %d", len(s.XValues), len(s.YValues))
+		}
+	case SeriesTime:
+		if len(s.XValues) > 0 {
+			ec.add(fieldPath(path, "xValues"), "is only valid for %q series", SeriesContinuous)
+		}
+		if len(s.Times) != len(s.YValues) {
+			ec.add(fieldPath(path, "times"), "has %d values but yValues has %d", len(s.Times), len(s.YValues))
+		}
+		for index, t := range s.Times {
+			if _, err := time.Parse(s.GetTimeFormat(), t); err != nil {
+				ec.add(indexPath(fieldPath(path, "times"), index), "cannot be parsed with format %q", s.GetTimeFormat())
+			}
+		}
+	default:
+		ec.add(fieldPath(path, "type"), "unknown series type %q", s.Type)
+	}
+
+	validateStyle(ec, fieldPath(path, "style"), s.Style)
+}
+
+func validateValues(ec *errorCollector, path string, values []Value, positive bool) {
+	for index, v := range values {
+		valuePath := indexPath(path, index)
+		if math.IsNaN(v.Value) || math.IsInf(v.Value, 0) {
+			ec.add(fieldPath(valuePath, "value"), "must be a finite number")
+		} else if positive && v.Value < 0 {
+			ec.add(fieldPath(valuePath, "value"), "must not be negative")
+		}
+		validateStyle(ec, fieldPath(valuePath, "style"), v.Style)
+	}
+}
+
+func validatePie(ec *errorCollector, path string, p *Pie) {
+	if p == nil {
+		return
+	}
+	if p.InnerRadius < 0 || p.InnerRadius >= 1 {
+		ec.add(fieldPath(path, "innerRadius"), "must be at least 0 and less than 1")
+	}
+	switch p.Direction {
+	case "", DirectionClockwise, DirectionCounterClockwise:
+	default:
+		ec.add(fieldPath(path, "direction"), "must be one of %q or %q", DirectionClockwise, DirectionCounterClockwise)
+	}
+	switch p.LabelPosition {
+	case "", LabelPositionInside, LabelPositionOutside:
+	default:
+		ec.add(fieldPath(path, "labelPosition"), "must be one of %q or %q", LabelPositionInside, LabelPositionOutside)
+	}
+	for index, offset := range p.Explode {
+		if offset < 0 {
+			ec.add(indexPath(fieldPath(path, "explode"), index), "must not be negative")
+		}
+	}
+}
+
+func validateAxis(ec *errorCollector, path string, a *Axis) {
+	if a == nil {
+		return
+	}
+	validateStyle(ec, fieldPath(path, "nameStyle"), a.NameStyle)
+	validateStyle(ec, fieldPath(path, "style"), a.Style)
+	validateStyle(ec, fieldPath(path, "gridMajorStyle"), a.GridMajorStyle)
+	validateStyle(ec, fieldPath(path, "gridMinorStyle"), a.GridMinorStyle)
+
+	if a.Range != nil {
+		rangePath := fieldPath(path, "range")
+		if a.Range.Min >= a.Range.Max {
+			ec.add(fieldPath(rangePath, "max"), "must be greater than min")
+		}
+		if a.Range.Logarithmic {
+			if a.Range.Min <= 0 {
+				ec.add(fieldPath(rangePath, "min"), "must be positive for a logarithmic range")
+			}
+			if a.Range.Base != 0 && (a.Range.Base <= 1) {
+				ec.add(fieldPath(rangePath, "base"), "must be greater than 1")
+			}
+		} else if a.Range.Base != 0 {
+			ec.add(fieldPath(rangePath, "base"), "is only valid for a logarithmic range")
+		}
+	}
+
+	switch a.Formatter {
+	case "", FormatterTimeHour, FormatterTimeMinute, FormatterTimeDate:
+		if a.Format != "" {
+			ec.add(fieldPath(path, "format"), "requires formatter %q, %q or %q", FormatterFloat, FormatterPercent, FormatterTime)
+		}
+	case FormatterPercent:
+		if a.Format != "" {
+			ec.add(fieldPath(path, "format"), "is not supported by formatter %q", FormatterPercent)
+		}
+	case FormatterFloat:
+		if a.Format != "" && !strings.Contains(a.Format, "%") {
+			ec.add(fieldPath(path, "format"), "must be a printf verb such as \"%%.2f\"")
+		}
+	case FormatterTime:
+	default:
+		ec.add(fieldPath(path, "formatter"), "unknown formatter %q", a.Formatter)
+	}
+}
+
+func validateStyle(ec *errorCollector, path string, s *Style) {
+	if s == nil {
+		return
+	}
+	validateColor(ec, fieldPath(path, "strokeColor"), s.StrokeColor)
+	validateColor(ec, fieldPath(path, "fillColor"), s.FillColor)
+	validateColor(ec, fieldPath(path, "dotColor"), s.DotColor)
+	validateColor(ec, fieldPath(path, "fontColor"), s.FontColor)
+
+	if s.StrokeWidth < 0 {
+		ec.add(fieldPath(path, "strokeWidth"), "must not be negative")
+	}
+	if s.DotWidth < 0 {
+		ec.add(fieldPath(path, "dotWidth"), "must not be negative")
+	}
+	if _, ok := DotShapes[s.DotShape]; s.DotShape != "" && !ok {
+		ec.add(fieldPath(path, "dotShape"), "unknown dot shape %q", s.DotShape)
+	}
+	if _, ok := Interpolations[s.Interpolation]; s.Interpolation != "" && !ok {
+		ec.add(fieldPath(path, "interpolation"), "unknown interpolation %q", s.Interpolation)
+	}
+	if s.FontSize < 0 {
+		ec.add(fieldPath(path, "fontSize"), "must not be negative")
+	}
+	for index, dash := range s.StrokeDashArray {
+		if dash < 0 {
+			ec.add(indexPath(fieldPath(path, "strokeDashArray"), index), "must not be negative")
+		}
+	}
+}
+
+func validateColor(ec *errorCollector, path, value string) {
+	if value == "" {
+		return
+	}
+	if _, ok := parseColor(value); !ok {
+		ec.add(path, "%q is not a hex color, expected #rgb, #rrggbb or #rrggbbaa", value)
+	}
+}
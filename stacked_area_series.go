@@ -0,0 +1,227 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// StackedAreaSeries draws a set of value providers that share x values on top of each other,
+// filling the band between each cumulative line and the one below it.
+// The first layer is drawn at the bottom of the stack.
+type StackedAreaSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Normalized draws each layer as a percentage of the total at each x value (a 100% stacked area).
+	Normalized bool
+	// LinesOnly draws only the cumulative lines without filling the bands (a stacked line chart).
+	LinesOnly bool
+
+	// Layers are the value providers to stack; they must have the same length and x values.
+	// Layers that are also a `StyleProvider` (e.g. `ContinuousSeries`) use their style for their band.
+	Layers []ValuesProvider
+
+	// ColorPalette colors the layers by their index, charts set it to their own palette if it's nil.
+	ColorPalette ColorPalette
+}
+
+// GetName returns the name of the series.
+func (sas StackedAreaSeries) GetName() string {
+	return sas.Name
+}
+
+// GetStyle returns the series style.
+func (sas StackedAreaSeries) GetStyle() Style {
+	return sas.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (sas StackedAreaSeries) GetYAxis() YAxisType {
+	return sas.YAxis
+}
+
+// GetColorPalette returns the color palette of the layers or the default palette.
+func (sas StackedAreaSeries) GetColorPalette() ColorPalette {
+	if sas.ColorPalette != nil {
+		return sas.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// WithDefaultColorPalette returns a copy of the series that colors its layers from the palette
+// if it has no palette of its own.
+func (sas StackedAreaSeries) WithDefaultColorPalette(cp ColorPalette) Series {
+	if sas.ColorPalette == nil {
+		sas.ColorPalette = cp
+	}
+	return sas
+}
+
+// Len returns the number of x values in the series.
+func (sas StackedAreaSeries) Len() int {
+	if len(sas.Layers) == 0 {
+		return 0
+	}
+	return sas.Layers[0].Len()
+}
+
+// GetValues gets the x value and the stacked total at a given index.
+func (sas StackedAreaSeries) GetValues(index int) (x, y float64) {
+	x, y, _ = sas.GetBoundedValues(index)
+	return
+}
+
+// GetBoundedValues gets the x value, the top and the bottom of the stack at a given index.
+func (sas StackedAreaSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x, cumulative := sas.getCumulativeValues(index)
+	for _, v := range cumulative {
+		y1 = math.Max(y1, v)
+		y2 = math.Min(y2, v)
+	}
+	return
+}
+
+// GetLayerValues gets the x value and the bottom and top of a given layer at a given index.
+func (sas StackedAreaSeries) GetLayerValues(layer, index int) (x, top, bottom float64) {
+	x, cumulative := sas.getCumulativeValues(index)
+	top = cumulative[layer]
+	if layer > 0 {
+		bottom = cumulative[layer-1]
+	}
+	return
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (sas StackedAreaSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x, y = FloatValueFormatter, FloatValueFormatter
+	if len(sas.Layers) > 0 {
+		if typed, isTyped := sas.Layers[0].(ValueFormatterProvider); isTyped {
+			x, y = typed.GetValueFormatters()
+		}
+	}
+	if sas.Normalized {
+		y = PercentValueFormatter
+	}
+	return
+}
+
+// getCumulativeValues returns the x value and the running total of each layer at a given index.
+func (sas StackedAreaSeries) getCumulativeValues(index int) (x float64, cumulative []float64) {
+	cumulative = make([]float64, len(sas.Layers))
+	var total, vy float64
+	for layer, vp := range sas.Layers {
+		x, vy = vp.GetValues(index)
+		total += vy
+		cumulative[layer] = total
+	}
+
+	if sas.Normalized {
+		var magnitude float64
+		for _, vp := range sas.Layers {
+			_, vy = vp.GetValues(index)
+			magnitude += math.Abs(vy)
+		}
+		for layer := range cumulative {
+			if magnitude == 0 {
+				cumulative[layer] = 0
+			} else {
+				cumulative[layer] = cumulative[layer] / magnitude
+			}
+		}
+	}
+	return
+}
+
+// Render renders the series.
+func (sas StackedAreaSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if sas.Len() == 0 {
+		return
+	}
+
+	for layer := range sas.Layers {
+		style := sas.getLayerStyle(layer, defaults)
+		band := stackedAreaLayer{series: sas, layer: layer}
+
+		if !sas.LinesOnly {
+			Draw.BoundedSeries(r, canvasBox, xrange, yrange, style.GetFillOptions(), band)
+		}
+		Draw.LineSeries(r, canvasBox, xrange, yrange, Style{
+			StrokeColor:     style.StrokeColor,
+			StrokeWidth:     style.StrokeWidth,
+			StrokeDashArray: style.StrokeDashArray,
+			DotColor:        style.DotColor,
+			DotWidth:        style.DotWidth,
+		}, band)
+	}
+}
+
+// getLayerStyle returns the style for a layer; the layer's own style takes precedence over
+// the series style, which takes precedence over the palette color for the layer index.
+func (sas StackedAreaSeries) getLayerStyle(layer int, defaults Style) Style {
+	var layerStyle Style
+	if typed, isTyped := sas.Layers[layer].(StyleProvider); isTyped {
+		layerStyle = typed.GetStyle()
+	}
+
+	color := sas.GetColorPalette().GetSeriesColor(layer)
+	layerDefaults := Style{
+		StrokeColor: color,
+		StrokeWidth: defaults.GetStrokeWidth(DefaultSeriesLineWidth),
+		FillColor:   color.WithAlpha(192),
+		DotColor:    color,
+	}
+	return layerStyle.InheritFrom(sas.Style.InheritFrom(layerDefaults.InheritFrom(defaults)))
+}
+
+// Validate validates the series.
+func (sas StackedAreaSeries) Validate() error {
+	if len(sas.Layers) == 0 {
+		return fmt.Errorf("stacked area series must have layers set")
+	}
+
+	length := sas.Layers[0].Len()
+	for layer, vp := range sas.Layers {
+		if vp.Len() != length {
+			return fmt.Errorf("stacked area series layer %d has %d values, expected %d", layer, vp.Len(), length)
+		}
+		for index := 0; index < length; index++ {
+			x, _ := vp.GetValues(index)
+			if x0, _ := sas.Layers[0].GetValues(index); x != x0 {
+				return fmt.Errorf("stacked area series layer %d has a different x value at index %d", layer, index)
+			}
+		}
+	}
+	return nil
+}
+
+// stackedAreaLayer is a single layer of a stacked area series; its values are the
+// top of the layer and its bounded values are the top and bottom of the layer.
+type stackedAreaLayer struct {
+	series StackedAreaSeries
+	layer  int
+}
+
+func (sal stackedAreaLayer) GetName() string {
+	if typed, isTyped := sal.series.Layers[sal.layer].(NameProvider); isTyped && len(typed.GetName()) > 0 {
+		return typed.GetName()
+	}
+	return sal.series.Name
+}
+
+func (sal stackedAreaLayer) GetValueFormatters() (x, y ValueFormatter) {
+	return sal.series.GetValueFormatters()
+}
+
+func (sal stackedAreaLayer) Len() int {
+	return sal.series.Len()
+}
+
+func (sal stackedAreaLayer) GetValues(index int) (x, y float64) {
+	x, y, _ = sal.series.GetLayerValues(sal.layer, index)
+	return
+}
+
+func (sal stackedAreaLayer) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return sal.series.GetLayerValues(sal.layer, index)
+}
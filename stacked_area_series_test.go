@@ -0,0 +1,123 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestStackedAreaSeriesValues(t *testing.T) {
+	assert := assert.New(t)
+
+	sas := StackedAreaSeries{
+		Layers: []ValuesProvider{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{4, 4, 4}},
+		},
+	}
+	assert.Equal(3, sas.Len())
+
+	x, y1, y2 := sas.GetBoundedValues(0)
+	assert.Equal(1.0, x)
+	assert.Equal(8.0, y1)
+	assert.Equal(0.0, y2)
+
+	_, top, bottom := sas.GetLayerValues(1, 2)
+	assert.Equal(4.0, top)
+	assert.Equal(3.0, bottom)
+}
+
+func TestStackedAreaSeriesNormalized(t *testing.T) {
+	assert := assert.New(t)
+
+	sas := StackedAreaSeries{
+		Layers: []ValuesProvider{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{4, 4, 4}},
+		},
+	}
+	sas.Normalized = true
+
+	_, y1, _ := sas.GetBoundedValues(1)
+	assert.Equal(1.0, y1)
+
+	_, top, bottom := sas.GetLayerValues(0, 1)
+	assert.Equal(0.25, top)
+	assert.Equal(0.0, bottom)
+
+	_, yf := sas.GetValueFormatters()
+	assert.Equal("50.00%", yf(0.5))
+}
+
+func TestStackedAreaSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(StackedAreaSeries{}.Validate())
+
+	sas := StackedAreaSeries{
+		Layers: []ValuesProvider{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+		},
+	}
+	assert.Nil(sas.Validate())
+
+	sas.Layers[1] = ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}
+	assert.NotNil(sas.Validate())
+
+	sas.Layers[1] = ContinuousSeries{XValues: []float64{1, 2, 4}, YValues: []float64{1, 2, 3}}
+	assert.NotNil(sas.Validate())
+}
+
+func TestStackedAreaSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			StackedAreaSeries{
+				Layers: []ValuesProvider{
+					ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+					ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+					ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{4, 4, 4}},
+				},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	_, yr, _ := c.getRanges()
+	assert.Equal(0.0, yr.GetMin())
+	assert.Equal(8.0, yr.GetMax())
+}
+
+func TestStackedAreaSeriesColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	var layers []ValuesProvider
+	for index := 0; index < 6; index++ {
+		layers = append(layers, ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}})
+	}
+	c := Chart{
+		ColorPalette: AlternateColorPalette,
+		Series:       []Series{StackedAreaSeries{Layers: layers}},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	svg := buffer.String()
+	for index := range layers {
+		assert.True(strings.Contains(svg, "fill:"+AlternateColorPalette.GetSeriesColor(index).WithAlpha(192).String()))
+	}
+
+	// the palette of the series takes precedence over the chart's.
+	sas := StackedAreaSeries{Layers: layers, ColorPalette: DefaultColorPalette}
+	assert.Equal(DefaultColorPalette, sas.WithDefaultColorPalette(AlternateColorPalette).(StackedAreaSeries).ColorPalette)
+	assert.Equal(GetDefaultColor(5), sas.getLayerStyle(5, Style{}).StrokeColor)
+}
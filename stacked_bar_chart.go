@@ -27,11 +27,15 @@ func (sb StackedBar) GetWidth() int {
 }
 
 // StackedBarChart is a chart that draws sections of a bar based on percentages.
+// If the orientation is horizontal, the `XAxis` style applies to the bar names on the left
+// and the `YAxis` style applies to the percentage ticks along the bottom.
 type StackedBarChart struct {
 	Title      string
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -45,6 +49,11 @@ type StackedBarChart struct {
 
 	BarSpacing int
 
+	Orientation BarOrientation
+
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -66,6 +75,9 @@ func (sbc StackedBarChart) GetDPI(defaults ...float64) float64 {
 // GetFont returns the text font.
 func (sbc StackedBarChart) GetFont() *truetype.Font {
 	if sbc.Font == nil {
+		if theme := sbc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return sbc.defaultFont
 	}
 	return sbc.Font
@@ -95,10 +107,23 @@ func (sbc StackedBarChart) GetBarSpacing() int {
 	return sbc.BarSpacing
 }
 
+// IsHorizontal returns if the bars grow left-to-right.
+func (sbc StackedBarChart) IsHorizontal() bool {
+	return sbc.Orientation == BarOrientationHorizontal
+}
+
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (sbc StackedBarChart) Validate() error {
+	if len(sbc.Bars) == 0 {
+		return FieldError{Field: "Bars", Err: errors.New("please provide at least one bar")}
+	}
+	return nil
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(sbc.Bars) == 0 {
-		return errors.New("please provide at least one bar")
+	if err := sbc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(sbc.GetWidth(), sbc.GetHeight())
@@ -115,11 +140,15 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	}
 	r.SetDPI(sbc.GetDPI(DefaultDPI))
 
-	canvasBox := sbc.getAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+	sbc.drawBackground(r)
+
+	legend := sbc.getLegend()
+	canvasBox := sbc.getAdjustedCanvasBox(r, legend.adjustCanvasBox(r, sbc.getDefaultCanvasBox()))
 	sbc.drawCanvas(r, canvasBox)
 	sbc.drawBars(r, canvasBox)
 	sbc.drawXAxis(r, canvasBox)
 	sbc.drawYAxis(r, canvasBox)
+	legend.draw(r, sbc.getDefaultCanvasBox(), canvasBox)
 
 	sbc.drawTitle(r)
 	for _, a := range sbc.Elements {
@@ -129,11 +158,34 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// RenderInto renders the chart into a box of an existing renderer.
+func (sbc StackedBarChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	sbc.Width, sbc.Height, sbc.DPI = box.Width(), box.Height(), sbc.GetDPI(r.GetDPI())
+	return sbc.Render(BoxRendererProvider(r, box), nil)
+}
+
+func (sbc StackedBarChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  sbc.GetWidth(),
+		Bottom: sbc.GetHeight(),
+	}, sbc.getBackgroundStyle())
+}
+
 func (sbc StackedBarChart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, sbc.getCanvasStyle())
 }
 
 func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box) {
+	if sbc.IsHorizontal() {
+		yoffset := canvasBox.Top
+		for _, bar := range sbc.Bars {
+			sbc.drawHorizontalBar(r, canvasBox, yoffset, bar)
+			yoffset += (sbc.GetBarSpacing() + bar.GetWidth())
+		}
+		return
+	}
+
 	xoffset := canvasBox.Left
 	for _, bar := range sbc.Bars {
 		sbc.drawBar(r, canvasBox, xoffset, bar)
@@ -163,7 +215,34 @@ func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, xoffset int, bar S
 	return bxr
 }
 
+func (sbc StackedBarChart) drawHorizontalBar(r Renderer, canvasBox Box, yoffset int, bar StackedBar) int {
+	barSpacing2 := sbc.GetBarSpacing() >> 1
+	byt := yoffset + barSpacing2
+	byb := byt + bar.GetWidth()
+
+	normalizedBarComponents := Values(bar.Values).Normalize()
+	xoffset := canvasBox.Left
+	for index, bv := range normalizedBarComponents {
+		barWidth := int(math.Ceil(bv.Value * float64(canvasBox.Width())))
+		barBox := Box{
+			Top:    byt,
+			Left:   xoffset,
+			Right:  util.Math.MinInt(xoffset+barWidth, canvasBox.Right-DefaultStrokeWidth),
+			Bottom: byb,
+		}
+		Draw.Box(r, barBox, bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)))
+		xoffset += barWidth
+	}
+
+	return byb
+}
+
 func (sbc StackedBarChart) drawXAxis(r Renderer, canvasBox Box) {
+	if sbc.IsHorizontal() {
+		sbc.drawHorizontalXAxis(r, canvasBox)
+		return
+	}
+
 	if sbc.XAxis.Show {
 		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -197,7 +276,50 @@ func (sbc StackedBarChart) drawXAxis(r Renderer, canvasBox Box) {
 	}
 }
 
+// drawHorizontalXAxis draws the bar names to the left of the canvas, centered on each bar.
+func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
+	if sbc.XAxis.Show {
+		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
+		labelStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsHorizontalLabels())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, canvasBox.Top)
+		r.Stroke()
+
+		labelRight := canvasBox.Left - DefaultYAxisMargin
+		labelLeft := util.Math.MaxInt(sbc.Box().Left, labelRight-sbc.getHorizontalLabelWrapWidth())
+
+		cursor := canvasBox.Top
+		for _, bar := range sbc.Bars {
+			barLabelBox := Box{
+				Top:    cursor,
+				Left:   labelLeft,
+				Right:  labelRight,
+				Bottom: cursor + bar.GetWidth() + sbc.GetBarSpacing(),
+			}
+			if len(bar.Name) > 0 {
+				Draw.TextWithin(r, bar.Name, barLabelBox, labelStyle)
+			}
+			axisStyle.WriteToRenderer(r)
+			r.MoveTo(canvasBox.Left, barLabelBox.Bottom)
+			r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, barLabelBox.Bottom)
+			r.Stroke()
+			cursor += bar.GetWidth() + sbc.GetBarSpacing()
+		}
+	}
+}
+
 func (sbc StackedBarChart) drawYAxis(r Renderer, canvasBox Box) {
+	if sbc.IsHorizontal() {
+		sbc.drawHorizontalYAxis(r, canvasBox)
+		return
+	}
+
 	if sbc.YAxis.Show {
 		axisStyle := sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
@@ -227,11 +349,38 @@ func (sbc StackedBarChart) drawYAxis(r Renderer, canvasBox Box) {
 	}
 }
 
+// drawHorizontalYAxis draws the percentage ticks along the bottom of the canvas.
+func (sbc StackedBarChart) drawHorizontalYAxis(r Renderer, canvasBox Box) {
+	if sbc.YAxis.Show {
+		axisStyle := sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		ticks := seq.RangeWithStep(0.0, 1.0, 0.2)
+		for _, t := range ticks {
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			tx := canvasBox.Left + int(t*float64(canvasBox.Width()))
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			text := fmt.Sprintf("%0.0f%%", t*100)
+
+			tb := r.MeasureText(text)
+			Draw.Text(r, text, tx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height(), axisStyle)
+		}
+	}
+}
+
 func (sbc StackedBarChart) drawTitle(r Renderer) {
 	if len(sbc.Title) > 0 && sbc.TitleStyle.Show {
-		r.SetFont(sbc.TitleStyle.GetFont(sbc.GetFont()))
-		r.SetFontColor(sbc.TitleStyle.GetFontColor(sbc.GetColorPalette().TextColor()))
-		titleFontSize := sbc.TitleStyle.GetFontSize(DefaultTitleFontSize)
+		titleStyle := sbc.TitleStyle.InheritFrom(sbc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(sbc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(sbc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(DefaultTitleFontSize)
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(sbc.Title)
@@ -240,22 +389,34 @@ func (sbc StackedBarChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (sbc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := sbc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
 
 		r.Text(sbc.Title, titleX, titleY)
 	}
 }
 
+func (sbc StackedBarChart) getBackgroundStyle() Style {
+	return sbc.Background.InheritFrom(sbc.styleDefaultsBackground())
+}
+
+func (sbc StackedBarChart) styleDefaultsBackground() Style {
+	return sbc.GetTheme().BackgroundStyle.InheritFrom(Style{
+		FillColor:   sbc.GetColorPalette().BackgroundColor(),
+		StrokeColor: sbc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
 func (sbc StackedBarChart) getCanvasStyle() Style {
 	return sbc.Canvas.InheritFrom(sbc.styleDefaultsCanvas())
 }
 
 func (sbc StackedBarChart) styleDefaultsCanvas() Style {
-	return Style{
+	return sbc.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   sbc.GetColorPalette().CanvasColor(),
 		StrokeColor: sbc.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultCanvasStrokeWidth,
-	}
+	})
 }
 
 // GetColorPalette returns the color palette for the chart.
@@ -263,14 +424,29 @@ func (sbc StackedBarChart) GetColorPalette() ColorPalette {
 	if sbc.ColorPalette != nil {
 		return sbc.ColorPalette
 	}
+	if theme := sbc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return AlternateColorPalette
 }
 
+// GetTheme returns the theme of the chart or the default theme.
+func (sbc StackedBarChart) GetTheme() Theme {
+	if sbc.Theme != nil {
+		return *sbc.Theme
+	}
+	return DefaultTheme
+}
+
 func (sbc StackedBarChart) getDefaultCanvasBox() Box {
 	return sbc.Box()
 }
 
 func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if sbc.IsHorizontal() {
+		return sbc.getAdjustedHorizontalCanvasBox(r, canvasBox)
+	}
+
 	var totalWidth int
 	for _, bar := range sbc.Bars {
 		totalWidth += bar.GetWidth() + sbc.GetBarSpacing()
@@ -297,11 +473,13 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 				xaxisHeight = util.Math.MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
 			}
 		}
+		// the names hang into the bottom padding, above a legend that shrank the canvas.
+		legendHeight := sbc.getDefaultCanvasBox().Bottom - canvasBox.Bottom
 		return Box{
 			Top:    canvasBox.Top,
 			Left:   canvasBox.Left,
 			Right:  canvasBox.Left + totalWidth,
-			Bottom: sbc.GetHeight() - xaxisHeight,
+			Bottom: sbc.GetHeight() - xaxisHeight - legendHeight,
 		}
 	}
 	return Box{
@@ -313,36 +491,79 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 
 }
 
+// getHorizontalLabelWrapWidth returns the width bar names wrap at when the bars are horizontal,
+// they wrap once they would take up more than a third of the chart.
+func (sbc StackedBarChart) getHorizontalLabelWrapWidth() int {
+	return sbc.Box().Width() / 3
+}
+
+func (sbc StackedBarChart) getAdjustedHorizontalCanvasBox(r Renderer, canvasBox Box) Box {
+	var totalHeight int
+	for _, bar := range sbc.Bars {
+		totalHeight += bar.GetWidth() + sbc.GetBarSpacing()
+	}
+
+	var labelWidth int
+	if sbc.XAxis.Show {
+		labelStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsHorizontalLabels())
+		labelStyle.WriteToRenderer(r)
+
+		for _, bar := range sbc.Bars {
+			if len(bar.Name) > 0 {
+				lines := Text.WrapFit(r, bar.Name, sbc.getHorizontalLabelWrapWidth(), labelStyle)
+				linesBox := Text.MeasureLines(r, lines, labelStyle)
+
+				labelWidth = util.Math.MaxInt(linesBox.Width()+DefaultYAxisMargin, labelWidth)
+			}
+		}
+	}
+
+	// leave room for the last percentage label, which is centered on the right edge.
+	var overhang int
+	if sbc.YAxis.Show {
+		sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes()).WriteToRenderer(r)
+		overhang = r.MeasureText("100%").Width() >> 1
+	}
+
+	return Box{
+		Top:    canvasBox.Top,
+		Left:   canvasBox.Left + labelWidth,
+		Right:  canvasBox.Right - overhang,
+		Bottom: canvasBox.Top + totalHeight,
+	}
+}
+
 // Box returns the chart bounds as a box.
 func (sbc StackedBarChart) Box() Box {
-	dpr := sbc.Background.Padding.GetRight(10)
-	dpb := sbc.Background.Padding.GetBottom(50)
+	padding := sbc.GetTheme().BackgroundStyle.Padding
+	dpr := sbc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := sbc.Background.Padding.GetBottom(padding.GetBottom(50))
 
 	return Box{
-		Top:    sbc.Background.Padding.GetTop(20),
-		Left:   sbc.Background.Padding.GetLeft(20),
+		Top:    sbc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   sbc.Background.Padding.GetLeft(padding.GetLeft(20)),
 		Right:  sbc.GetWidth() - dpr,
 		Bottom: sbc.GetHeight() - dpb,
 	}
 }
 
 func (sbc StackedBarChart) styleDefaultsStackedBarValue(index int) Style {
-	return Style{
+	return sbc.GetTheme().SeriesStyle.InheritFrom(Style{
 		StrokeColor: sbc.GetColorPalette().GetSeriesColor(index),
 		StrokeWidth: 3.0,
 		FillColor:   sbc.GetColorPalette().GetSeriesColor(index),
-	}
+	})
 }
 
 func (sbc StackedBarChart) styleDefaultsTitle() Style {
-	return sbc.TitleStyle.InheritFrom(Style{
-		FontColor:           DefaultTextColor,
+	return sbc.TitleStyle.InheritFrom(sbc.GetTheme().TitleStyle.InheritFrom(Style{
+		FontColor:           sbc.GetColorPalette().TextColor(),
 		Font:                sbc.GetFont(),
 		FontSize:            sbc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
-	})
+	}))
 }
 
 func (sbc StackedBarChart) getTitleFontSize() float64 {
@@ -360,16 +581,49 @@ func (sbc StackedBarChart) getTitleFontSize() float64 {
 }
 
 func (sbc StackedBarChart) styleDefaultsAxes() Style {
-	return Style{
-		StrokeColor:         DefaultAxisColor,
+	return sbc.GetTheme().inheritAxisStyle(Style{
+		StrokeColor:         sbc.GetColorPalette().AxisStrokeColor(),
 		Font:                sbc.GetFont(),
 		FontSize:            DefaultAxisFontSize,
-		FontColor:           DefaultAxisColor,
+		FontColor:           sbc.GetColorPalette().TextColor(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
+	})
+}
+
+func (sbc StackedBarChart) styleDefaultsHorizontalLabels() Style {
+	return sbc.GetTheme().inheritAxisStyle(Style{
+		Font:                sbc.GetFont(),
+		FontSize:            DefaultAxisFontSize,
+		FontColor:           sbc.GetColorPalette().TextColor(),
+		TextHorizontalAlign: TextHorizontalAlignRight,
+		TextVerticalAlign:   TextVerticalAlignMiddle,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for every labeled component of the first bar,
+// the components at the same index of the other bars share its color.
+func (sbc StackedBarChart) GetLegendEntries() []LegendEntry {
+	if len(sbc.Bars) == 0 {
+		return nil
 	}
+	var entries []LegendEntry
+	for index, bv := range Values(sbc.Bars[0].Values).Normalize() {
+		entries = append(entries, LegendEntry{
+			Label:  bv.Label,
+			Style:  bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)),
+			Marker: LegendMarkerBox,
+		})
+	}
+	return entries
+}
+
+func (sbc StackedBarChart) getLegend() chartLegend {
+	return newChartLegend(sbc.Legend, sbc, styleDefaultsLegend(sbc.GetTheme(), sbc.GetFont(), sbc.GetColorPalette()))
 }
+
 func (sbc StackedBarChart) styleDefaultsElements() Style {
 	return Style{
 		Font: sbc.GetFont(),
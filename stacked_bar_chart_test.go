@@ -0,0 +1,43 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+)
+
+func TestStackedBarChartRenderHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Orientation: BarOrientationHorizontal,
+		XAxis:       StyleShow(),
+		YAxis:       StyleShow(),
+		Bars: []StackedBar{
+			{
+				Name:   "A Rather Long Bar Name",
+				Values: []Value{{Value: 1.0}, {Value: 3.0}},
+			},
+			{
+				Name:   "Two",
+				Values: []Value{{Value: 2.0}, {Value: 2.0}},
+			},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	sbc.Font = f
+
+	r, err := PNG(sbc.GetWidth(), sbc.GetHeight())
+	assert.Nil(err)
+
+	cb := sbc.getAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+	assert.True(cb.Left > sbc.Box().Left)
+	assert.Equal(2*(sbc.GetBarSpacing()+StackedBar{}.GetWidth()), cb.Height())
+}
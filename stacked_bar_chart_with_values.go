@@ -30,6 +30,8 @@ type StackedValueBarChart struct {
 	TitleStyle Style
 
 	ColorPalette ColorPalette
+	// Theme is the look of the chart, it's `DefaultTheme` if nil.
+	Theme *Theme
 
 	Width  int
 	Height int
@@ -45,6 +47,9 @@ type StackedValueBarChart struct {
 
 	BarSpacing int
 
+	// Legend is a legend of the chart, it's drawn if `Legend.Style.Show` is set.
+	Legend LegendOptions
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -66,6 +71,9 @@ func (sbc StackedValueBarChart) GetDPI(defaults ...float64) float64 {
 // GetFont returns the text font.
 func (sbc StackedValueBarChart) GetFont() *truetype.Font {
 	if sbc.Font == nil {
+		if theme := sbc.GetTheme(); theme.Font != nil {
+			return theme.Font
+		}
 		return sbc.defaultFont
 	}
 	return sbc.Font
@@ -107,17 +115,25 @@ func (sbc StackedValueBarChart) getBackgroundStyle() Style {
 }
 
 func (sbc StackedValueBarChart) styleDefaultsBackground() Style {
-	return Style{
+	return sbc.GetTheme().BackgroundStyle.InheritFrom(Style{
 		FillColor:   sbc.GetColorPalette().BackgroundColor(),
 		StrokeColor: sbc.GetColorPalette().BackgroundStrokeColor(),
 		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+// Validate validates the chart, returning a FieldError that points at the offending field.
+func (sbc StackedValueBarChart) Validate() error {
+	if len(sbc.Bars) == 0 {
+		return FieldError{Field: "Bars", Err: errors.New("please provide at least one bar")}
 	}
+	return nil
 }
 
 // Render renders the chart with the given renderer to the given io.Writer.
 func (sbc StackedValueBarChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(sbc.Bars) == 0 {
-		return errors.New("please provide at least one bar")
+	if err := sbc.Validate(); err != nil {
+		return err
 	}
 
 	r, err := rp(sbc.GetWidth(), sbc.GetHeight())
@@ -141,7 +157,8 @@ func (sbc StackedValueBarChart) Render(rp RendererProvider, w io.Writer) error {
 	var yr Range
 	var yf ValueFormatter
 
-	canvasBox = sbc.getDefaultCanvasBox()
+	legend := sbc.getLegend()
+	canvasBox = legend.adjustCanvasBox(r, sbc.getDefaultCanvasBox())
 	yr = sbc.getRanges()
 	if yr.GetMax()-yr.GetMin() == 0 {
 		// return fmt.Errorf("invalid data range; cannot be zero")
@@ -168,6 +185,7 @@ func (sbc StackedValueBarChart) Render(rp RendererProvider, w io.Writer) error {
 	sbc.drawBars(r, canvasBox, yr)
 	sbc.drawXAxis(r, canvasBox)
 	sbc.drawYAxis(r, canvasBox, yr, yt)
+	legend.draw(r, sbc.getDefaultCanvasBox(), canvasBox)
 
 	sbc.drawTitle(r)
 	for _, a := range sbc.Elements {
@@ -177,6 +195,13 @@ func (sbc StackedValueBarChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
+// RenderInto renders the chart into a box of an existing renderer.
+func (sbc StackedValueBarChart) RenderInto(r Renderer, box Box) error {
+	defer r.SetDPI(r.GetDPI())
+	sbc.Width, sbc.Height, sbc.DPI = box.Width(), box.Height(), sbc.GetDPI(r.GetDPI())
+	return sbc.Render(BoxRendererProvider(r, box), nil)
+}
+
 func (sbc StackedValueBarChart) getAxesTicks(r Renderer, yr Range, yf ValueFormatter) (yticks []Tick) {
 	if sbc.YAxis.Style.Show {
 		yticks = sbc.YAxis.GetTicks(r, yr, sbc.styleDefaultsAxes(), yf)
@@ -381,9 +406,10 @@ func (sbc StackedValueBarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, t
 
 func (sbc StackedValueBarChart) drawTitle(r Renderer) {
 	if len(sbc.Title) > 0 && sbc.TitleStyle.Show {
-		r.SetFont(sbc.TitleStyle.GetFont(sbc.GetFont()))
-		r.SetFontColor(sbc.TitleStyle.GetFontColor(sbc.GetColorPalette().TextColor()))
-		titleFontSize := sbc.TitleStyle.GetFontSize(DefaultTitleFontSize)
+		titleStyle := sbc.TitleStyle.InheritFrom(sbc.GetTheme().TitleStyle)
+		r.SetFont(titleStyle.GetFont(sbc.GetFont()))
+		r.SetFontColor(titleStyle.GetFontColor(sbc.GetColorPalette().TextColor()))
+		titleFontSize := titleStyle.GetFontSize(DefaultTitleFontSize)
 		r.SetFontSize(titleFontSize)
 
 		textBox := r.MeasureText(sbc.Title)
@@ -392,7 +418,7 @@ func (sbc StackedValueBarChart) drawTitle(r Renderer) {
 		textHeight := textBox.Height()
 
 		titleX := (sbc.GetWidth() >> 1) - (textWidth >> 1)
-		titleY := sbc.TitleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
+		titleY := titleStyle.Padding.GetTop(DefaultTitleTop) + textHeight
 
 		r.Text(sbc.Title, titleX, titleY)
 	}
@@ -403,11 +429,11 @@ func (sbc StackedValueBarChart) getCanvasStyle() Style {
 }
 
 func (sbc StackedValueBarChart) styleDefaultsCanvas() Style {
-	return Style{
+	return sbc.GetTheme().CanvasStyle.InheritFrom(Style{
 		FillColor:   sbc.GetColorPalette().CanvasColor(),
 		StrokeColor: sbc.GetColorPalette().CanvasStrokeColor(),
 		StrokeWidth: DefaultCanvasStrokeWidth,
-	}
+	})
 }
 
 // GetColorPalette returns the color palette for the chart.
@@ -415,9 +441,20 @@ func (sbc StackedValueBarChart) GetColorPalette() ColorPalette {
 	if sbc.ColorPalette != nil {
 		return sbc.ColorPalette
 	}
+	if theme := sbc.GetTheme(); theme.ColorPalette != nil {
+		return theme.ColorPalette
+	}
 	return AlternateColorPalette
 }
 
+// GetTheme returns the theme of the chart or the default theme.
+func (sbc StackedValueBarChart) GetTheme() Theme {
+	if sbc.Theme != nil {
+		return *sbc.Theme
+	}
+	return DefaultTheme
+}
+
 func (sbc StackedValueBarChart) getDefaultCanvasBox() Box {
 	return sbc.box()
 }
@@ -513,39 +550,40 @@ func (sbc StackedValueBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box,
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
 
-	return canvasBox.OuterConstrain(sbc.box(), axesOuterBox)
+	return canvasBox.OuterConstrain(sbc.getLegend().adjustCanvasBox(r, sbc.box()), axesOuterBox)
 
 }
 
 func (sbc StackedValueBarChart) box() Box {
-	dpr := sbc.Background.Padding.GetRight(10)
-	dpb := sbc.Background.Padding.GetBottom(50)
+	padding := sbc.GetTheme().BackgroundStyle.Padding
+	dpr := sbc.Background.Padding.GetRight(padding.GetRight(10))
+	dpb := sbc.Background.Padding.GetBottom(padding.GetBottom(50))
 
 	return Box{
-		Top:    sbc.Background.Padding.GetTop(20),
-		Left:   sbc.Background.Padding.GetLeft(20),
+		Top:    sbc.Background.Padding.GetTop(padding.GetTop(20)),
+		Left:   sbc.Background.Padding.GetLeft(padding.GetLeft(20)),
 		Right:  sbc.GetWidth() - dpr,
 		Bottom: sbc.GetHeight() - dpb,
 	}
 }
 
 func (sbc StackedValueBarChart) styleDefaultsStackedValueBarValue(index int) Style {
-	return Style{
+	return sbc.GetTheme().SeriesStyle.InheritFrom(Style{
 		StrokeColor: sbc.GetColorPalette().GetSeriesColor(index),
 		StrokeWidth: 3.0,
 		FillColor:   sbc.GetColorPalette().GetSeriesColor(index),
-	}
+	})
 }
 
 func (sbc StackedValueBarChart) styleDefaultsTitle() Style {
-	return sbc.TitleStyle.InheritFrom(Style{
+	return sbc.TitleStyle.InheritFrom(sbc.GetTheme().TitleStyle.InheritFrom(Style{
 		FontColor:           sbc.GetColorPalette().TextColor(),
 		Font:                sbc.GetFont(),
 		FontSize:            sbc.getTitleFontSize(),
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
-	})
+	}))
 }
 
 func (sbc StackedValueBarChart) getTitleFontSize() float64 {
@@ -563,7 +601,7 @@ func (sbc StackedValueBarChart) getTitleFontSize() float64 {
 }
 
 func (sbc StackedValueBarChart) styleDefaultsAxes() Style {
-	return Style{
+	return sbc.GetTheme().inheritAxisStyle(Style{
 		StrokeColor:         sbc.GetColorPalette().AxisStrokeColor(),
 		Font:                sbc.GetFont(),
 		FontSize:            DefaultAxisFontSize,
@@ -571,8 +609,32 @@ func (sbc StackedValueBarChart) styleDefaultsAxes() Style {
 		TextHorizontalAlign: TextHorizontalAlignCenter,
 		TextVerticalAlign:   TextVerticalAlignTop,
 		TextWrap:            TextWrapWord,
+	})
+}
+
+// GetLegendEntries returns an entry for the title of every value of the first bar,
+// the values at the same index of the other bars share its color.
+func (sbc StackedValueBarChart) GetLegendEntries() []LegendEntry {
+	if len(sbc.Bars) == 0 {
+		return nil
+	}
+	var entries []LegendEntry
+	for index, bv := range sbc.Bars[0].Values {
+		if bv.Style.IsZero() || bv.Style.Show {
+			entries = append(entries, LegendEntry{
+				Label:  bv.Title,
+				Style:  bv.Style.InheritFrom(sbc.styleDefaultsStackedValueBarValue(index)),
+				Marker: LegendMarkerBox,
+			})
+		}
 	}
+	return entries
 }
+
+func (sbc StackedValueBarChart) getLegend() chartLegend {
+	return newChartLegend(sbc.Legend, sbc, styleDefaultsLegend(sbc.GetTheme(), sbc.GetFont(), sbc.GetColorPalette()))
+}
+
 func (sbc StackedValueBarChart) styleDefaultsElements() Style {
 	return Style{
 		Font: sbc.GetFont(),
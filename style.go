@@ -45,12 +45,16 @@ type Style struct {
 
 	DotColor drawing.Color
 	DotWidth float64
+	DotShape DotShape
 
 	DotWidthProvider SizeProvider
 	DotColorProvider DotColorProvider
+	DotShapeProvider DotShapeProvider
 
 	FillColor drawing.Color
 
+	Interpolation Interpolation
+
 	FontSize  float64
 	FontColor drawing.Color
 	Font      *truetype.Font
@@ -210,6 +214,28 @@ func (s Style) GetDotWidth(defaults ...float64) float64 {
 	return s.DotWidth
 }
 
+// GetDotShape returns the dot shape, or a default circle.
+func (s Style) GetDotShape(defaults ...DotShape) DotShape {
+	if s.DotShape == nil {
+		if len(defaults) > 0 && defaults[0] != nil {
+			return defaults[0]
+		}
+		return DotShapeCircle
+	}
+	return s.DotShape
+}
+
+// GetInterpolation returns how lines connect their points or a default.
+func (s Style) GetInterpolation(defaults ...Interpolation) Interpolation {
+	if s.Interpolation == InterpolationLinear {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return InterpolationLinear
+	}
+	return s.Interpolation
+}
+
 // GetStrokeDashArray returns the stroke dash array.
 func (s Style) GetStrokeDashArray(defaults ...[]float64) []float64 {
 	if len(s.StrokeDashArray) == 0 {
@@ -358,11 +384,17 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 
 	final.DotColor = s.GetDotColor(defaults.DotColor)
 	final.DotWidth = s.GetDotWidth(defaults.DotWidth)
+	final.DotShape = s.DotShape
+	if final.DotShape == nil {
+		final.DotShape = defaults.DotShape
+	}
 
 	final.DotWidthProvider = s.DotWidthProvider
 	final.DotColorProvider = s.DotColorProvider
+	final.DotShapeProvider = s.DotShapeProvider
 
 	final.FillColor = s.GetFillColor(defaults.FillColor)
+	final.Interpolation = s.GetInterpolation(defaults.Interpolation)
 	final.FontColor = s.GetFontColor(defaults.FontColor)
 	final.FontSize = s.GetFontSize(defaults.FontSize)
 	final.Font = s.GetFont(defaults.Font)
@@ -433,7 +465,7 @@ func (s Style) ShouldDrawStroke() bool {
 
 // ShouldDrawDot tells drawing functions if they should draw the dot.
 func (s Style) ShouldDrawDot() bool {
-	return (!s.DotColor.IsZero() && s.DotWidth > 0) || s.DotColorProvider != nil || s.DotWidthProvider != nil
+	return (!s.DotColor.IsZero() && s.DotWidth > 0) || s.DotColorProvider != nil || s.DotWidthProvider != nil || s.DotShapeProvider != nil
 }
 
 // ShouldDrawFill tells drawing functions if they should draw the stroke.
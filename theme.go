@@ -0,0 +1,162 @@
+package chart
+
+import (
+	"github.com/daill/go-chart/drawing"
+	"github.com/golang/freetype/truetype"
+)
+
+// Theme is the look of a chart beyond the styles set on it: its colors, font, and the
+// font sizes, stroke widths and padding of its parts.
+//
+// The styles of a chart take precedence over its theme, which takes precedence over the
+// defaults of the chart type. The colors of series come from the color palette, so the
+// series style of a theme shouldn't set any.
+type Theme struct {
+	// ColorPalette is used by charts without a color palette of their own, they fall back to
+	// their default palette if it's nil.
+	ColorPalette ColorPalette
+	// Font is used by charts without a font of their own, they fall back to the default font if it's nil.
+	Font *truetype.Font
+
+	BackgroundStyle Style
+	CanvasStyle     Style
+	// SeriesStyle is the style of lines, bars, boxes, bubbles and slices.
+	SeriesStyle Style
+	// AxisStyle is the style of axes: their lines, ticks, labels and names.
+	AxisStyle Style
+	// TickStyle is the style of the ticks and tick labels of axes. As axes draw their lines and
+	// names with their default style too, it applies to them and takes precedence over `AxisStyle`.
+	TickStyle      Style
+	GridMajorStyle Style
+	GridMinorStyle Style
+	TitleStyle     Style
+	LegendStyle    Style
+}
+
+var (
+	// ThemeLight is dark text and axes on white, with the default colors of each chart type.
+	ThemeLight = Theme{
+		GridMajorStyle: Style{StrokeColor: DefaultGridLineColor, StrokeWidth: 1.0},
+		GridMinorStyle: Style{StrokeColor: DefaultGridLineColor, StrokeWidth: 0.5},
+	}
+
+	// ThemeDark is light text and axes on a dark gray background, with brighter series colors.
+	ThemeDark = Theme{
+		ColorPalette: themeColorPalette{
+			background: drawing.Color{R: 30, G: 30, B: 30, A: 255},
+			canvas:     drawing.Color{R: 30, G: 30, B: 30, A: 255},
+			axisStroke: drawing.Color{R: 190, G: 190, B: 190, A: 255},
+			text:       drawing.Color{R: 225, G: 225, B: 225, A: 255},
+			series: []drawing.Color{
+				{R: 77, G: 166, B: 255, A: 255},
+				{R: 0, G: 230, B: 118, A: 255},
+				{R: 255, G: 82, B: 153, A: 255},
+				{R: 0, G: 229, B: 221, A: 255},
+				{R: 255, G: 152, B: 56, A: 255},
+				{R: 255, G: 235, B: 59, A: 255},
+			},
+		},
+		GridMajorStyle: Style{StrokeColor: drawing.Color{R: 70, G: 70, B: 70, A: 255}, StrokeWidth: 1.0},
+		GridMinorStyle: Style{StrokeColor: drawing.Color{R: 50, G: 50, B: 50, A: 255}, StrokeWidth: 0.5},
+	}
+
+	// ThemeHighContrast is black text and axes on white with saturated series colors,
+	// thicker lines and larger text.
+	ThemeHighContrast = Theme{
+		ColorPalette: themeColorPalette{
+			background: ColorWhite,
+			canvas:     ColorWhite,
+			axisStroke: drawing.ColorBlack,
+			text:       drawing.ColorBlack,
+			series: []drawing.Color{
+				{R: 0, G: 0, B: 0, A: 255},
+				{R: 0, G: 90, B: 200, A: 255},
+				{R: 200, G: 0, B: 0, A: 255},
+				{R: 0, G: 130, B: 0, A: 255},
+				{R: 160, G: 0, B: 160, A: 255},
+				{R: 190, G: 95, B: 0, A: 255},
+			},
+		},
+		SeriesStyle:    Style{StrokeWidth: 3.0},
+		AxisStyle:      Style{StrokeWidth: 2.0, FontSize: 12.0},
+		TitleStyle:     Style{FontSize: 22.0},
+		LegendStyle:    Style{StrokeWidth: 2.0, FontSize: 11.0},
+		GridMajorStyle: Style{StrokeColor: drawing.Color{R: 120, G: 120, B: 120, A: 255}, StrokeWidth: 1.0},
+		GridMinorStyle: Style{StrokeColor: drawing.Color{R: 180, G: 180, B: 180, A: 255}, StrokeWidth: 1.0},
+	}
+
+	// ThemePrint is black on white with gray series colors and thin dashed grid lines,
+	// for charts printed without color.
+	ThemePrint = Theme{
+		ColorPalette: themeColorPalette{
+			background: ColorWhite,
+			canvas:     ColorWhite,
+			axisStroke: drawing.ColorBlack,
+			text:       drawing.ColorBlack,
+			series: []drawing.Color{
+				{R: 0, G: 0, B: 0, A: 255},
+				{R: 110, G: 110, B: 110, A: 255},
+				{R: 170, G: 170, B: 170, A: 255},
+				{R: 55, G: 55, B: 55, A: 255},
+				{R: 140, G: 140, B: 140, A: 255},
+			},
+		},
+		SeriesStyle:    Style{StrokeWidth: 1.5},
+		AxisStyle:      Style{StrokeWidth: 0.5},
+		GridMajorStyle: Style{StrokeColor: drawing.Color{R: 160, G: 160, B: 160, A: 255}, StrokeWidth: 0.5, StrokeDashArray: []float64{3, 3}},
+		GridMinorStyle: Style{StrokeColor: drawing.Color{R: 200, G: 200, B: 200, A: 255}, StrokeWidth: 0.5, StrokeDashArray: []float64{1, 2}},
+	}
+
+	// DefaultTheme is the theme of charts without a theme of their own.
+	DefaultTheme = ThemeLight
+)
+
+// inheritAxisStyle returns the default style of axes inheriting from the tick and axis styles of the theme.
+func (t Theme) inheritAxisStyle(defaults Style) Style {
+	return t.TickStyle.InheritFrom(t.AxisStyle.InheritFrom(defaults))
+}
+
+// inheritGridStyles returns the grid styles of an axis inheriting from the grid styles of the theme.
+func (t Theme) inheritGridStyles(major, minor Style) (Style, Style) {
+	themedMajor, themedMinor := major.InheritFrom(t.GridMajorStyle), minor.InheritFrom(t.GridMinorStyle)
+	themedMajor.Show, themedMinor.Show = major.Show, minor.Show
+	return themedMajor, themedMinor
+}
+
+// themeColorPalette is the color palette of a built-in theme, the background and canvas
+// are drawn without a stroke.
+type themeColorPalette struct {
+	background drawing.Color
+	canvas     drawing.Color
+	axisStroke drawing.Color
+	text       drawing.Color
+	series     []drawing.Color
+}
+
+func (tp themeColorPalette) BackgroundColor() drawing.Color {
+	return tp.background
+}
+
+func (tp themeColorPalette) BackgroundStrokeColor() drawing.Color {
+	return tp.background
+}
+
+func (tp themeColorPalette) CanvasColor() drawing.Color {
+	return tp.canvas
+}
+
+func (tp themeColorPalette) CanvasStrokeColor() drawing.Color {
+	return tp.canvas
+}
+
+func (tp themeColorPalette) AxisStrokeColor() drawing.Color {
+	return tp.axisStroke
+}
+
+func (tp themeColorPalette) TextColor() drawing.Color {
+	return tp.text
+}
+
+func (tp themeColorPalette) GetSeriesColor(index int) drawing.Color {
+	return tp.series[index%len(tp.series)]
+}
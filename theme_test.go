@@ -0,0 +1,163 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/drawing"
+)
+
+func TestThemeColorPalette(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{}
+	assert.Equal(DefaultColorPalette, c.GetColorPalette())
+
+	c.Theme = &ThemeDark
+	assert.Equal(ThemeDark.ColorPalette, c.GetColorPalette())
+
+	// the palette of the chart takes precedence over the theme.
+	c.ColorPalette = AlternateColorPalette
+	assert.Equal(AlternateColorPalette, c.GetColorPalette())
+
+	// charts with another default palette keep it with a theme without one.
+	bc := BarChart{Theme: &ThemeLight}
+	assert.Equal(AlternateColorPalette, bc.GetColorPalette())
+}
+
+func TestThemeStyleDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := Theme{
+		SeriesStyle: Style{StrokeWidth: 4},
+		AxisStyle:   Style{FontSize: 14, StrokeWidth: 2},
+		TickStyle:   Style{FontSize: 9},
+		LegendStyle: Style{FontSize: 12},
+	}
+	c := Chart{Theme: &theme}
+
+	series := c.styleDefaultsSeries(1)
+	assert.Equal(4.0, series.StrokeWidth)
+	assert.Equal(DefaultColorPalette.GetSeriesColor(1), series.StrokeColor)
+
+	axes := c.styleDefaultsAxes()
+	assert.Equal(9.0, axes.FontSize)
+	assert.Equal(2.0, axes.StrokeWidth)
+	assert.Equal(DefaultColorPalette.AxisStrokeColor(), axes.StrokeColor)
+
+	assert.Equal(12.0, c.getLegend().style.FontSize)
+
+	// the styles of the chart take precedence over the theme.
+	assert.Equal(16.0, Style{FontSize: 16}.InheritFrom(c.styleDefaultsAxes()).FontSize)
+}
+
+func TestThemeInheritGridStyles(t *testing.T) {
+	assert := assert.New(t)
+
+	major, minor := ThemeDark.inheritGridStyles(StyleShow(), Style{StrokeColor: drawing.ColorRed})
+	assert.True(major.Show)
+	assert.Equal(ThemeDark.GridMajorStyle.StrokeColor, major.StrokeColor)
+	assert.False(minor.Show)
+	assert.Equal(drawing.ColorRed, minor.StrokeColor)
+	assert.Equal(ThemeDark.GridMinorStyle.StrokeWidth, minor.StrokeWidth)
+}
+
+func TestThemeBackgroundPadding(t *testing.T) {
+	assert := assert.New(t)
+
+	theme := Theme{BackgroundStyle: Style{Padding: Box{Top: 30}}}
+	c := Chart{Width: 200, Height: 100, Theme: &theme, Background: Style{Padding: Box{Left: 15}}}
+	box := c.Box()
+	assert.Equal(30, box.Top)
+	assert.Equal(15, box.Left)
+	assert.Equal(200-DefaultBackgroundPadding.Right, box.Right)
+}
+
+func TestThemeRender(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, theme := range []Theme{ThemeLight, ThemeDark, ThemeHighContrast, ThemePrint} {
+		theme := theme
+		graphs := []Graph{
+			Chart{
+				Theme: &theme,
+				XAxis: XAxis{Style: StyleShow(), GridMajorStyle: StyleShow()},
+				YAxis: YAxis{Style: StyleShow(), GridMajorStyle: StyleShow()},
+				Series: []Series{
+					ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+				},
+			},
+			BarChart{Theme: &theme, Bars: []Value{{Value: 1}, {Value: 2}}},
+			StackedBarChart{Theme: &theme, Bars: []StackedBar{{Values: []Value{{Value: 1}, {Value: 2}}}}},
+			PieChart{Theme: &theme, Values: []Value{{Value: 1}, {Value: 2}}},
+		}
+		for _, graph := range graphs {
+			buf := bytes.NewBuffer([]byte{})
+			assert.Nil(graph.Render(PNG, buf))
+			assert.NotZero(buf.Len())
+		}
+	}
+}
+
+func TestThemeStackedBarChartLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Title:      "Dark",
+		TitleStyle: StyleShow(),
+		Theme:      &ThemeDark,
+		XAxis:      StyleShow(),
+		YAxis:      StyleShow(),
+		Bars: []StackedBar{
+			{Name: "a", Values: []Value{{Label: "x", Value: 1}, {Label: "y", Value: 2}}},
+			{Name: "b", Values: []Value{{Label: "x", Value: 2}, {Label: "y", Value: 1}}},
+		},
+	}
+	text := ThemeDark.ColorPalette.TextColor()
+	assert.Equal(text, sbc.styleDefaultsTitle().FontColor)
+	assert.Equal(text, sbc.styleDefaultsAxes().FontColor)
+	assert.Equal(ThemeDark.ColorPalette.AxisStrokeColor(), sbc.styleDefaultsAxes().StrokeColor)
+	assert.Equal(text, sbc.styleDefaultsHorizontalLabels().FontColor)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(SVG, buffer))
+	assert.True(strings.Contains(buffer.String(), "fill:"+text.String()))
+	assert.False(strings.Contains(buffer.String(), "fill:"+DefaultTextColor.String()))
+	assert.False(strings.Contains(buffer.String(), "fill:"+DefaultAxisColor.String()))
+
+	// slices are separated by the background color.
+	assert.Equal(ThemeDark.ColorPalette.BackgroundColor(), PieChart{Theme: &ThemeDark}.stylePieChartValue(0).StrokeColor)
+}
+
+func TestThemeStackedAreaSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	layers := []ValuesProvider{
+		ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+	}
+	for _, theme := range []Theme{ThemeDark, ThemeHighContrast} {
+		theme := theme
+		c := Chart{
+			Theme:  &theme,
+			Series: []Series{StackedAreaSeries{Layers: layers}},
+		}
+
+		buffer := bytes.NewBuffer([]byte{})
+		assert.Nil(c.Render(SVG, buffer))
+		svg := buffer.String()
+		for index := range layers {
+			color := theme.ColorPalette.GetSeriesColor(index)
+			assert.True(strings.Contains(svg, "fill:"+color.WithAlpha(192).String()))
+			assert.True(strings.Contains(svg, "stroke:"+color.String()))
+		}
+		assert.False(strings.Contains(svg, "fill:"+GetDefaultColor(0).WithAlpha(192).String()))
+	}
+
+	// the layers are as thick as the other series of the theme.
+	c := Chart{Theme: &ThemeHighContrast}
+	sas := StackedAreaSeries{Layers: layers}
+	assert.Equal(ThemeHighContrast.SeriesStyle.StrokeWidth, sas.getLayerStyle(1, c.styleDefaultsSeries(0)).StrokeWidth)
+}
@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	util "github.com/daill/go-chart/util"
@@ -13,6 +14,18 @@ type TicksProvider interface {
 	GetTicks(r Renderer, defaults Style, vf ValueFormatter) []Tick
 }
 
+// OrientedTicksProvider is a ticks provider whose ticks depend on the orientation of its axis,
+// i.e. labels are spaced by their height on a y axis. Axes set the orientation before getting the ticks.
+type OrientedTicksProvider interface {
+	TicksProvider
+	SetVertical(isVertical bool)
+}
+
+// MinorTicksProvider is a type that provides the minor tick values between major ticks.
+type MinorTicksProvider interface {
+	GetMinorTicks(ticks []Tick, subdivisions int) []float64
+}
+
 // Tick represents a label on an axis.
 type Tick struct {
 	Value float64
@@ -115,3 +128,47 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 
 	return ticks
 }
+
+// GenerateMinorTicks splits each interval between the major ticks into `subdivisions` even parts
+// and returns the values in between, extending past the first and last tick up to the range bounds.
+func GenerateMinorTicks(ra Range, ticks []Tick, subdivisions int) []float64 {
+	if subdivisions < 2 || len(ticks) < 2 {
+		return nil
+	}
+
+	majors := make([]float64, len(ticks))
+	for index, t := range ticks {
+		majors[index] = t.Value
+	}
+	sort.Float64s(majors)
+
+	min, max := math.Min(ra.GetMin(), ra.GetMax()), math.Max(ra.GetMin(), ra.GetMax())
+	var values []float64
+	add := func(value float64) {
+		if value >= min && value <= max && len(values) < DefaultTickCountSanityCheck {
+			values = append(values, value)
+		}
+	}
+
+	firstStep := (majors[1] - majors[0]) / float64(subdivisions)
+	if firstStep > 0 {
+		for value := majors[0] - firstStep; value >= min; value -= firstStep {
+			add(value)
+		}
+	}
+	for index := 1; index < len(majors); index++ {
+		step := (majors[index] - majors[index-1]) / float64(subdivisions)
+		for subdivision := 1; subdivision < subdivisions; subdivision++ {
+			add(majors[index-1] + float64(subdivision)*step)
+		}
+	}
+	lastStep := (majors[len(majors)-1] - majors[len(majors)-2]) / float64(subdivisions)
+	if lastStep > 0 {
+		for value := majors[len(majors)-1] + lastStep; value <= max; value += lastStep {
+			add(value)
+		}
+	}
+
+	sort.Float64s(values)
+	return values
+}
@@ -58,3 +58,16 @@ func TestGenerateContinuousTicksDescending(t *testing.T) {
 	assert.Equal(1.0, ticks[len(ticks)-2].Value)
 	assert.Equal(0.0, ticks[len(ticks)-1].Value)
 }
+
+func TestGenerateMinorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &ContinuousRange{Min: 0.5, Max: 3}
+	ticks := []Tick{{Value: 1}, {Value: 2}}
+	assert.Equal([]float64{0.5, 0.75, 1.25, 1.5, 1.75, 2.25, 2.5, 2.75, 3}, GenerateMinorTicks(ra, ticks, 4))
+
+	// descending ticks subdivide the same way.
+	assert.Equal(GenerateMinorTicks(ra, ticks, 4), GenerateMinorTicks(ra, []Tick{{Value: 2}, {Value: 1}}, 4))
+	assert.Empty(GenerateMinorTicks(ra, ticks, 1))
+	assert.Empty(GenerateMinorTicks(ra, ticks[:1], 4))
+}
@@ -0,0 +1,374 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	util "github.com/daill/go-chart/util"
+)
+
+// TimeUnit is a calendar unit time ticks are aligned to.
+type TimeUnit int
+
+// TimeUnit values.
+const (
+	TimeUnitSecond TimeUnit = iota
+	TimeUnitMinute
+	TimeUnitHour
+	TimeUnitDay
+	TimeUnitWeek
+	TimeUnitMonth
+	TimeUnitYear
+)
+
+// TimeInterval is a calendar aligned tick interval, i.e. every 15 minutes or every 3 months.
+type TimeInterval struct {
+	Unit  TimeUnit
+	Count int
+
+	// Format is the time format of the labels.
+	Format string
+	// Parent is the unit that is shown on boundaries with BoundaryFormat, i.e. the month for days.
+	Parent TimeUnit
+	// BoundaryFormat is the time format of the first label and of labels that start a new parent period.
+	BoundaryFormat string
+}
+
+var (
+	// DefaultTimeIntervals are the intervals a TimeRange picks from, finest first.
+	DefaultTimeIntervals = []TimeInterval{
+		{Unit: TimeUnitSecond, Count: 1, Format: "15:04:05", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04:05"},
+		{Unit: TimeUnitSecond, Count: 5, Format: "15:04:05", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04:05"},
+		{Unit: TimeUnitSecond, Count: 15, Format: "15:04:05", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04:05"},
+		{Unit: TimeUnitSecond, Count: 30, Format: "15:04:05", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04:05"},
+		{Unit: TimeUnitMinute, Count: 1, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitMinute, Count: 5, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitMinute, Count: 15, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitMinute, Count: 30, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitHour, Count: 1, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitHour, Count: 3, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitHour, Count: 6, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitHour, Count: 12, Format: "15:04", Parent: TimeUnitDay, BoundaryFormat: "Jan 2 15:04"},
+		{Unit: TimeUnitDay, Count: 1, Format: "2", Parent: TimeUnitMonth, BoundaryFormat: "Jan 2"},
+		{Unit: TimeUnitDay, Count: 2, Format: "2", Parent: TimeUnitMonth, BoundaryFormat: "Jan 2"},
+		{Unit: TimeUnitWeek, Count: 1, Format: "2", Parent: TimeUnitMonth, BoundaryFormat: "Jan 2"},
+		{Unit: TimeUnitMonth, Count: 1, Format: "Jan", Parent: TimeUnitYear, BoundaryFormat: "Jan 2006"},
+		{Unit: TimeUnitMonth, Count: 3, Format: "Jan", Parent: TimeUnitYear, BoundaryFormat: "Jan 2006"},
+		{Unit: TimeUnitMonth, Count: 6, Format: "Jan", Parent: TimeUnitYear, BoundaryFormat: "Jan 2006"},
+		{Unit: TimeUnitYear, Count: 1, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 2, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 5, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 10, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 25, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 50, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+		{Unit: TimeUnitYear, Count: 100, Format: "2006", Parent: TimeUnitYear, BoundaryFormat: "2006"},
+	}
+)
+
+// GetCount returns the number of units per interval, at least one.
+func (ti TimeInterval) GetCount() int {
+	if ti.Count < 1 {
+		return 1
+	}
+	return ti.Count
+}
+
+// Duration returns the approximate length of the interval.
+func (ti TimeInterval) Duration() time.Duration {
+	var unit time.Duration
+	switch ti.Unit {
+	case TimeUnitSecond:
+		unit = time.Second
+	case TimeUnitMinute:
+		unit = time.Minute
+	case TimeUnitHour:
+		unit = time.Hour
+	case TimeUnitDay:
+		unit = 24 * time.Hour
+	case TimeUnitWeek:
+		unit = 7 * 24 * time.Hour
+	case TimeUnitMonth:
+		unit = 30 * 24 * time.Hour
+	default:
+		unit = 365 * 24 * time.Hour
+	}
+	return time.Duration(ti.GetCount()) * unit
+}
+
+// Truncate returns the start of the interval containing t, in the location of t.
+// Weeks start on `weekStart`.
+func (ti TimeInterval) Truncate(t time.Time, weekStart time.Weekday) time.Time {
+	count := ti.GetCount()
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	loc := t.Location()
+
+	switch ti.Unit {
+	case TimeUnitSecond:
+		return time.Date(year, month, day, hour, min, sec-sec%count, 0, loc)
+	case TimeUnitMinute:
+		return time.Date(year, month, day, hour, min-min%count, 0, 0, loc)
+	case TimeUnitHour:
+		return time.Date(year, month, day, hour-hour%count, 0, 0, 0, loc)
+	case TimeUnitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case TimeUnitWeek:
+		offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+	case TimeUnitMonth:
+		monthIndex := int(month) - 1
+		return time.Date(year, time.Month(monthIndex-monthIndex%count+1), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year-year%count, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// Next returns the start of the interval after the one starting at t.
+// Calendar units are added on the wall clock, so days stay aligned to midnight across daylight saving changes.
+func (ti TimeInterval) Next(t time.Time) time.Time {
+	count := ti.GetCount()
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	loc := t.Location()
+
+	switch ti.Unit {
+	case TimeUnitSecond:
+		return t.Add(time.Duration(count) * time.Second)
+	case TimeUnitMinute:
+		return t.Add(time.Duration(count) * time.Minute)
+	case TimeUnitHour:
+		return time.Date(year, month, day, hour+count, min, sec, 0, loc)
+	case TimeUnitDay:
+		return time.Date(year, month, day+count, hour, min, sec, 0, loc)
+	case TimeUnitWeek:
+		return time.Date(year, month, day+7*count, hour, min, sec, 0, loc)
+	case TimeUnitMonth:
+		return time.Date(year, month+time.Month(count), day, hour, min, sec, 0, loc)
+	default:
+		return time.Date(year+count, month, day, hour, min, sec, 0, loc)
+	}
+}
+
+// Times returns the interval starts between from and to inclusive.
+func (ti TimeInterval) Times(from, to time.Time, weekStart time.Weekday) []time.Time {
+	var times []time.Time
+	cursor := ti.Truncate(from, weekStart)
+	if cursor.Before(from) {
+		cursor = ti.Next(cursor)
+	}
+	for !cursor.After(to) && len(times) < DefaultTickCountSanityCheck {
+		times = append(times, cursor)
+		cursor = ti.Next(cursor)
+	}
+	return times
+}
+
+// Labels formats times as tick labels, using the boundary format for the first time and
+// for every time that starts a new parent period, i.e. "Jan 30", "31", "Feb 1", "2".
+func (ti TimeInterval) Labels(times []time.Time, weekStart time.Weekday) []string {
+	parent := TimeInterval{Unit: ti.Parent}
+	labels := make([]string, len(times))
+	for index, t := range times {
+		if index == 0 || !parent.Truncate(t, weekStart).Equal(parent.Truncate(times[index-1], weekStart)) {
+			labels[index] = t.Format(ti.BoundaryFormat)
+		} else {
+			labels[index] = t.Format(ti.Format)
+		}
+	}
+	return labels
+}
+
+// TimeRange is a range of timestamps (as nanoseconds, see `util.Time.ToFloat64`) whose ticks fall on
+// calendar aligned intervals in a given location, i.e. midnight, the first of the month or every 15 minutes.
+// The finest interval whose labels fit the domain is used.
+type TimeRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Location is the location the intervals are aligned in, defaults to `time.Local`.
+	Location *time.Location
+	// WeekStart is the first day of weekly intervals, defaults to Sunday.
+	WeekStart time.Weekday
+	// Intervals are the candidate intervals ordered finest first, defaults to `DefaultTimeIntervals`.
+	Intervals []TimeInterval
+	// ValueFormatter overrides the hierarchical labels of the intervals if set.
+	ValueFormatter ValueFormatter
+
+	// IsVertical spaces the tick labels by their height instead of their width, the axis sets it.
+	IsVertical bool
+}
+
+// GetLocation returns the location or a default.
+func (r TimeRange) GetLocation() *time.Location {
+	if r.Location == nil {
+		return time.Local
+	}
+	return r.Location
+}
+
+// GetIntervals returns the candidate intervals or a default.
+func (r TimeRange) GetIntervals() []TimeInterval {
+	if len(r.Intervals) == 0 {
+		return DefaultTimeIntervals
+	}
+	return r.Intervals
+}
+
+// GetMinTime returns the min value as a time in the range location.
+func (r TimeRange) GetMinTime() time.Time {
+	return util.Time.FromFloat64(r.Min).In(r.GetLocation())
+}
+
+// GetMaxTime returns the max value as a time in the range location.
+func (r TimeRange) GetMaxTime() time.Time {
+	return util.Time.FromFloat64(r.Max).In(r.GetLocation())
+}
+
+// IsDescending returns if the range is descending.
+func (r TimeRange) IsDescending() bool {
+	return r.Descending
+}
+
+// IsZero returns if the TimeRange has been set or not.
+func (r TimeRange) IsZero() bool {
+	return (r.Min == 0 || math.IsNaN(r.Min)) &&
+		(r.Max == 0 || math.IsNaN(r.Max)) &&
+		r.Domain == 0
+}
+
+// GetMin gets the min value for the range.
+func (r TimeRange) GetMin() float64 {
+	return r.Min
+}
+
+// SetMin sets the min value for the range.
+func (r *TimeRange) SetMin(min float64) {
+	r.Min = min
+}
+
+// GetMax gets the max value for the range.
+func (r TimeRange) GetMax() float64 {
+	return r.Max
+}
+
+// SetMax sets the max value for the range.
+func (r *TimeRange) SetMax(max float64) {
+	r.Max = max
+}
+
+// GetDelta returns the difference between the min and max value.
+func (r TimeRange) GetDelta() float64 {
+	return r.Max - r.Min
+}
+
+// GetDomain returns the range domain.
+func (r TimeRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *TimeRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// SetVertical sets if the range is on a vertical axis.
+func (r *TimeRange) SetVertical(isVertical bool) {
+	r.IsVertical = isVertical
+}
+
+// String returns a simple string for the TimeRange.
+func (r TimeRange) String() string {
+	return fmt.Sprintf("TimeRange [%s,%s] => %d", r.GetMinTime().Format(time.RFC3339), r.GetMaxTime().Format(time.RFC3339), r.Domain)
+}
+
+// Translate maps a given value into the TimeRange space.
+func (r TimeRange) Translate(value float64) int {
+	normalized := value - r.Min
+	ratio := normalized / r.GetDelta()
+
+	if r.IsDescending() {
+		return r.Domain - int(math.Ceil(ratio*float64(r.Domain)))
+	}
+
+	return int(math.Ceil(ratio * float64(r.Domain)))
+}
+
+// GetInterval returns the finest interval whose labels fit the domain, and its times and labels.
+func (r *TimeRange) GetInterval(rr Renderer, defaults Style) (interval TimeInterval, times []time.Time, labels []string) {
+	from, to := r.GetMinTime(), r.GetMaxTime()
+	span := to.Sub(from)
+
+	defaults.GetTextOptions().WriteToRenderer(rr)
+	intervals := r.GetIntervals()
+	for _, interval = range intervals {
+		// skip intervals that would obviously not fit before generating their times.
+		if estimate := float64(span) / float64(interval.Duration()); estimate > float64(DefaultTickCountSanityCheck) ||
+			(r.Domain > 0 && estimate*float64(r.getMinimumTickSpacing()) > float64(r.Domain)) {
+			continue
+		}
+		times = interval.Times(from, to, r.WeekStart)
+		labels = r.formatLabels(interval, times)
+		if r.measureLabels(rr, labels) <= r.Domain {
+			return
+		}
+	}
+	return
+}
+
+func (r *TimeRange) formatLabels(interval TimeInterval, times []time.Time) []string {
+	if r.ValueFormatter == nil {
+		return interval.Labels(times, r.WeekStart)
+	}
+	labels := make([]string, len(times))
+	for index, t := range times {
+		labels[index] = r.ValueFormatter(t)
+	}
+	return labels
+}
+
+func (r *TimeRange) measureLabels(rr Renderer, labels []string) int {
+	var total int
+	for index, label := range labels {
+		if r.IsVertical {
+			total += rr.MeasureText(label).Height()
+		} else {
+			total += rr.MeasureText(label).Width()
+		}
+		if index > 0 {
+			total += r.getMinimumTickSpacing()
+		}
+	}
+	return total
+}
+
+func (r *TimeRange) getMinimumTickSpacing() int {
+	if r.IsVertical {
+		return DefaultMinimumTickVerticalSpacing
+	}
+	return DefaultMinimumTickHorizontalSpacing
+}
+
+// GetTicks returns ticks at the starts of the finest interval whose labels fit the domain,
+// labelled with the parent period on its boundaries.
+func (r *TimeRange) GetTicks(rr Renderer, defaults Style, vf ValueFormatter) []Tick {
+	if r.Max <= r.Min {
+		if vf == nil {
+			vf = TimeValueFormatter
+		}
+		return []Tick{{Value: r.Min, Label: vf(r.Min)}}
+	}
+
+	_, times, labels := r.GetInterval(rr, defaults)
+	ticks := make([]Tick, len(times))
+	for index := range times {
+		timeIndex := index
+		if r.IsDescending() {
+			timeIndex = len(times) - 1 - index
+		}
+		ticks[index] = Tick{Value: util.Time.ToFloat64(times[timeIndex]), Label: labels[timeIndex]}
+	}
+	return ticks
+}
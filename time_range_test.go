@@ -0,0 +1,131 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/blend/go-sdk/assert"
+	"github.com/daill/go-chart/util"
+)
+
+func TestTimeIntervalTruncate(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := time.Date(2024, time.August, 14, 17, 38, 41, 5, time.UTC)
+	assert.Equal(time.Date(2024, time.August, 14, 17, 38, 40, 0, time.UTC), TimeInterval{Unit: TimeUnitSecond, Count: 5}.Truncate(ts, time.Sunday))
+	assert.Equal(time.Date(2024, time.August, 14, 17, 30, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitMinute, Count: 15}.Truncate(ts, time.Sunday))
+	assert.Equal(time.Date(2024, time.August, 14, 12, 0, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitHour, Count: 6}.Truncate(ts, time.Sunday))
+	assert.Equal(time.Date(2024, time.August, 11, 0, 0, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitWeek}.Truncate(ts, time.Sunday))
+	assert.Equal(time.Date(2024, time.August, 12, 0, 0, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitWeek}.Truncate(ts, time.Monday))
+	assert.Equal(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitMonth, Count: 3}.Truncate(ts, time.Sunday))
+	assert.Equal(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), TimeInterval{Unit: TimeUnitYear, Count: 5}.Truncate(ts, time.Sunday))
+}
+
+func TestTimeIntervalTimesLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	eastern := util.Date.Eastern()
+	// spans the switch to daylight saving time, the days should stay aligned to midnight.
+	from := time.Date(2024, time.March, 9, 6, 0, 0, 0, eastern)
+	to := time.Date(2024, time.March, 12, 6, 0, 0, 0, eastern)
+
+	times := TimeInterval{Unit: TimeUnitDay}.Times(from, to, time.Sunday)
+	assert.Len(3, times)
+	for _, ts := range times {
+		assert.Zero(ts.Hour())
+	}
+	assert.Equal(23*time.Hour, times[1].Sub(times[0]))
+}
+
+func TestTimeIntervalLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	interval := TimeInterval{Unit: TimeUnitDay, Format: "2", Parent: TimeUnitMonth, BoundaryFormat: "Jan 2"}
+	times := interval.Times(time.Date(2024, time.January, 30, 0, 0, 0, 0, time.UTC), time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC), time.Sunday)
+	assert.Equal([]string{"Jan 30", "31", "Feb 1", "2"}, interval.Labels(times, time.Sunday))
+}
+
+func TestTimeRangeGetTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: 10}
+
+	tr := &TimeRange{
+		Min:      util.Time.ToFloat64(time.Date(2024, time.January, 10, 13, 0, 0, 0, time.UTC)),
+		Max:      util.Time.ToFloat64(time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC)),
+		Domain:   300,
+		Location: time.UTC,
+	}
+
+	interval, _, _ := tr.GetInterval(r, style)
+	assert.Equal(TimeUnitMonth, interval.Unit)
+
+	ticks := tr.GetTicks(r, style, TimeValueFormatter)
+	assert.Len(3, ticks)
+	assert.Equal(util.Time.ToFloat64(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)), ticks[0].Value)
+	assert.Equal("Feb 2024", ticks[0].Label)
+	assert.Equal("Mar", ticks[1].Label)
+
+	// a wider domain fits finer intervals.
+	tr.Domain = 4000
+	interval, _, _ = tr.GetInterval(r, style)
+	assert.True(interval.Unit == TimeUnitDay || interval.Unit == TimeUnitWeek)
+
+	tr.Descending = true
+	ticks = tr.GetTicks(r, style, TimeValueFormatter)
+	assert.True(ticks[0].Value > ticks[len(ticks)-1].Value)
+}
+
+func TestTimeRangeTicksOrientation(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	style := Style{Font: f, FontSize: DefaultFontSize}
+
+	tr := &TimeRange{
+		Min:      util.Time.ToFloat64(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)),
+		Max:      util.Time.ToFloat64(time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC)),
+		Domain:   400,
+		Location: time.UTC,
+	}
+	yticks := YAxis{}.GetTicks(r, tr, style, nil)
+	assert.True(tr.IsVertical)
+	xticks := XAxis{}.GetTicks(r, tr, style, nil)
+	assert.False(tr.IsVertical)
+	assert.True(len(yticks) > len(xticks))
+}
+
+func TestTimeRangeTranslate(t *testing.T) {
+	assert := assert.New(t)
+
+	tr := TimeRange{Min: 0, Max: 1000, Domain: 100}
+	assert.Equal(0, tr.Translate(0))
+	assert.Equal(50, tr.Translate(500))
+	tr.Descending = true
+	assert.Equal(100, tr.Translate(0))
+}
+
+func TestChartTimeRange(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := Chart{
+		XAxis: XAxis{Style: StyleShow(), Range: &TimeRange{Location: time.UTC}},
+		YAxis: YAxis{Style: StyleShow()},
+		Series: []Series{
+			TimeSeries{XValues: []time.Time{start, start.AddDate(0, 0, 10), start.AddDate(0, 0, 45)}, YValues: []float64{1, 3, 2}},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
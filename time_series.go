@@ -14,6 +14,9 @@ type TimeSeries struct {
 
 	YAxis YAxisType
 
+	// ErrorBars are the uncertainties of the values, drawn around each value.
+	ErrorBars ErrorBars
+
 	XValues []time.Time
 	YValues []float64
 }
@@ -63,6 +66,12 @@ func (ts TimeSeries) GetYAxis() YAxisType {
 func (ts TimeSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := ts.Style.InheritFrom(defaults)
 	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ts)
+	Draw.ErrorBars(r, canvasBox, xrange, yrange, ts.ErrorBars.GetStyle(style), ts, ts.ErrorBars)
+}
+
+// GetErrorBars returns the error bars of the series.
+func (ts TimeSeries) GetErrorBars() ErrorBars {
+	return ts.ErrorBars
 }
 
 // Validate validates the series.
@@ -74,5 +83,9 @@ func (ts TimeSeries) Validate() error {
 	if len(ts.YValues) == 0 {
 		return fmt.Errorf("time series must have yvalues set")
 	}
-	return nil
+
+	if len(ts.XValues) != len(ts.YValues) {
+		return fmt.Errorf("time series has %d xvalues but %d yvalues", len(ts.XValues), len(ts.YValues))
+	}
+	return ts.ErrorBars.Validate(len(ts.XValues))
 }
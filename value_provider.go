@@ -44,3 +44,6 @@ type ColorProvider func(v, vmin, vmax float64) drawing.Color
 
 // DotColorProvider is a provider for dot color.
 type DotColorProvider func(xrange, yrange Range, index int, x, y float64) drawing.Color
+
+// DotShapeProvider is a provider for dot shape.
+type DotShapeProvider func(xrange, yrange Range, index int, x, y float64) DotShape
@@ -3,6 +3,7 @@ package chart
 import (
 	"bytes"
 	"fmt"
+	"html"
 	"io"
 	"math"
 	"strings"
@@ -28,6 +29,17 @@ func SVG(width, height int) (Renderer, error) {
 	}, nil
 }
 
+// SVGInteractive returns a new svg renderer that tags the elements series draw with the series
+// and data point they represent: each element gets a `<title>` tooltip, `data-series`, `data-x`
+// and `data-y` attributes, and css class names derived from the series name (see `ElementInfo`).
+func SVGInteractive(width, height int) (Renderer, error) {
+	vr, err := SVG(width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &interactiveVectorRenderer{vectorRenderer: vr.(*vectorRenderer)}, nil
+}
+
 // vectorRenderer renders chart commands to a bitmap.
 type vectorRenderer struct {
 	dpi float64
@@ -89,9 +101,11 @@ func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
 	vr.p = append(vr.p, fmt.Sprintf("Q%d,%d %d,%d", cx, cy, x, y))
 }
 
+// ArcTo implements the interface method.
+// A negative delta draws the arc counter clockwise, arcs over half a turn are split in two as
+// svg draws nothing for an arc between equal points, i.e. a full circle.
 func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	startAngle = util.Math.RadianAdd(startAngle, _pi2)
-	endAngle := util.Math.RadianAdd(startAngle, delta)
 
 	startx := cx + int(rx*math.Sin(startAngle))
 	starty := cy - int(ry*math.Cos(startAngle))
@@ -102,17 +116,21 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 		vr.p = append(vr.p, fmt.Sprintf("M %d %d", startx, starty))
 	}
 
-	endx := cx + int(rx*math.Sin(endAngle))
-	endy := cy - int(ry*math.Cos(endAngle))
-
-	dd := util.Math.RadiansToDegrees(delta)
-
-	largeArcFlag := 0
-	if delta > _pi {
-		largeArcFlag = 1
+	sweepFlag := 1
+	if delta < 0 {
+		sweepFlag = 0
+	}
+	segments := 1
+	if math.Abs(delta) > _pi {
+		segments = 2
+	}
+	step := delta / float64(segments)
+	for index := 1; index <= segments; index++ {
+		endAngle := startAngle + step*float64(index)
+		endx := cx + int(rx*math.Sin(endAngle))
+		endy := cy - int(ry*math.Cos(endAngle))
+		vr.p = append(vr.p, fmt.Sprintf("A %d %d 0 0 %d %d %d", int(rx), int(ry), sweepFlag, endx, endy))
 	}
-
-	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d 1 %d %d", int(rx), int(ry), dd, largeArcFlag, endx, endy))
 }
 
 // Close closes a shape.
@@ -137,6 +155,9 @@ func (vr *vectorRenderer) FillStroke() {
 
 // drawPath draws a path.
 func (vr *vectorRenderer) drawPath(s Style) {
+	if len(vr.p) == 0 {
+		return // e.g. a fill after `Circle`, which draws itself
+	}
 	vr.c.Path(strings.Join(vr.p, "\n"), vr.s.GetFillAndStrokeOptions())
 	vr.p = []string{} // clear the path
 }
@@ -204,6 +225,21 @@ func (vr *vectorRenderer) Save(w io.Writer) error {
 	return err
 }
 
+// interactiveVectorRenderer is a vector renderer that tags the elements it draws.
+type interactiveVectorRenderer struct {
+	*vectorRenderer
+}
+
+// SetElementInfo implements the interface method.
+func (ivr *interactiveVectorRenderer) SetElementInfo(info ElementInfo) {
+	ivr.c.info = &info
+}
+
+// ClearElementInfo implements the interface method.
+func (ivr *interactiveVectorRenderer) ClearElementInfo() {
+	ivr.c.info = nil
+}
+
 func newCanvas(w io.Writer) *canvas {
 	return &canvas{
 		w:   w,
@@ -217,6 +253,7 @@ type canvas struct {
 	textTheta *float64
 	width     int
 	height    int
+	info      *ElementInfo
 }
 
 func (c *canvas) Start(width, height int) {
@@ -230,7 +267,7 @@ func (c *canvas) Path(d string, style Style) {
 	if len(style.StrokeDashArray) > 0 {
 		strokeDashArrayProperty = c.getStrokeDashArray(style)
 	}
-	c.w.Write([]byte(fmt.Sprintf(`<path %s d="%s" style="%s"/>`, strokeDashArrayProperty, d, c.styleAsSVG(style))))
+	c.w.Write([]byte(fmt.Sprintf(`<path %s%s d="%s" style="%s"%s`, strokeDashArrayProperty, c.getInfoAttributes(), d, c.styleAsSVG(style), c.getInfoEnd("path"))))
 }
 
 func (c *canvas) Text(x, y int, body string, style Style) {
@@ -243,13 +280,39 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 }
 
 func (c *canvas) Circle(x, y, r int, style Style) {
-	c.w.Write([]byte(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" style="%s"/>`, x, y, r, c.styleAsSVG(style))))
+	c.w.Write([]byte(fmt.Sprintf(`<circle%s cx="%d" cy="%d" r="%d" style="%s"%s`, c.getInfoAttributes(), x, y, r, c.styleAsSVG(style), c.getInfoEnd("circle"))))
 }
 
 func (c *canvas) End() {
 	c.w.Write([]byte("</svg>"))
 }
 
+// getInfoAttributes returns the class and data attributes for the current element info.
+func (c *canvas) getInfoAttributes() string {
+	if c.info == nil {
+		return ""
+	}
+	attributes := []string{
+		fmt.Sprintf(`class="%s"`, c.info.ClassName()),
+		fmt.Sprintf(`data-series="%s"`, html.EscapeString(c.info.Series)),
+	}
+	if c.info.HasValue() {
+		attributes = append(attributes,
+			fmt.Sprintf(`data-x="%s"`, html.EscapeString(c.info.X)),
+			fmt.Sprintf(`data-y="%s"`, html.EscapeString(c.info.Y)),
+		)
+	}
+	return " " + strings.Join(attributes, " ")
+}
+
+// getInfoEnd closes an element, adding a title tooltip for the current element info.
+func (c *canvas) getInfoEnd(element string) string {
+	if c.info == nil || len(c.info.Title()) == 0 {
+		return "/>"
+	}
+	return fmt.Sprintf("><title>%s</title></%s>", html.EscapeString(c.info.Title()), element)
+}
+
 // getStrokeDashArray returns the stroke-dasharray property of a style.
 func (c *canvas) getStrokeDashArray(s Style) string {
 	if len(s.StrokeDashArray) > 0 {
@@ -75,3 +75,31 @@ func TestCanvasStyleSVG(t *testing.T) {
 	assert.True(strings.Contains(svgString, "stroke-width:5"))
 	assert.True(strings.Contains(svgString, "fill:rgba(255,255,255,1.0)"))
 }
+
+func TestVectorRendererInteractive(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "Foo & Bar",
+				Style:   Style{Show: true, StrokeColor: ColorBlue, DotWidth: 2, DotColor: ColorBlue},
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{4, 5, 6},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVGInteractive, buffer))
+	raw := buffer.String()
+
+	assert.True(strings.Contains(raw, `class="series series-foo-bar" data-series="Foo &amp; Bar" data-x="2.00" data-y="5.00"`))
+	assert.True(strings.Contains(raw, `<title>Foo &amp; Bar: 2.00, 5.00</title></path>`))
+	assert.True(strings.Contains(raw, `<title>Foo &amp; Bar: 3.00, 6.00</title></circle>`))
+	assert.Equal(6, strings.Count(raw, "<title>"))
+
+	buffer.Reset()
+	assert.Nil(c.Render(SVG, buffer))
+	assert.False(strings.Contains(buffer.String(), "data-series"))
+}
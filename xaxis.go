@@ -19,6 +19,11 @@ type XAxis struct {
 	Ticks        []Tick
 	TickPosition TickPosition
 
+	// MinorSubdivisions is the number of parts each major tick interval is split into by minor ticks,
+	// i.e. 5 draws four minor ticks between two major ticks. Logarithmic ranges subdivide each decade instead.
+	// Minor ticks and minor grid lines are not drawn if it is less than 2.
+	MinorSubdivisions int
+
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
@@ -62,6 +67,9 @@ func (xa XAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter
 	if len(xa.Ticks) > 0 {
 		return xa.Ticks
 	}
+	if op, isOriented := ra.(OrientedTicksProvider); isOriented {
+		op.SetVertical(false)
+	}
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
 		return tp.GetTicks(r, defaults, vf)
 	}
@@ -77,6 +85,37 @@ func (xa XAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, xa.GridMajorStyle, xa.GridMinorStyle)
 }
 
+// getGridLines returns the gridlines for the axis, preferring gridlines provided by the range
+// over generated gridlines if the user hasn't supplied any.
+func (xa XAxis) getGridLines(ra Range, ticks []Tick) []GridLine {
+	if len(xa.GridLines) > 0 {
+		return xa.GridLines
+	}
+	if glp, isGridLineProvider := ra.(GridLineProvider); isGridLineProvider {
+		gridLines := glp.GetGridLines(ticks, true, xa.GridMajorStyle, xa.GridMinorStyle)
+		if xa.MinorSubdivisions < 2 {
+			// minor grid lines go with minor ticks, so they are left out without minor subdivisions.
+			return majorGridLines(gridLines)
+		}
+		return gridLines
+	}
+	if xa.MinorSubdivisions > 1 {
+		return GenerateGridLinesWithMinorTicks(ra, ticks, xa.GetMinorTicks(ra, ticks), xa.GridMajorStyle, xa.GridMinorStyle)
+	}
+	return xa.GetGridLines(ticks)
+}
+
+// GetMinorTicks returns the minor tick values for the axis, or nothing if it has no minor subdivisions.
+func (xa XAxis) GetMinorTicks(ra Range, ticks []Tick) []float64 {
+	if xa.MinorSubdivisions < 2 {
+		return nil
+	}
+	if mtp, isMinorTicksProvider := ra.(MinorTicksProvider); isMinorTicksProvider {
+		return mtp.GetMinorTicks(ticks, xa.MinorSubdivisions)
+	}
+	return GenerateMinorTicks(ra, ticks, xa.MinorSubdivisions)
+}
+
 // Measure returns the bounds of the axis.
 func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
@@ -181,6 +220,14 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		}
 	}
 
+	tickStyle.GetStrokeOptions().WriteToRenderer(r)
+	for _, v := range xa.GetMinorTicks(ra, ticks) {
+		tx = canvasBox.Left + ra.Translate(v)
+		r.MoveTo(tx, canvasBox.Bottom)
+		r.LineTo(tx, canvasBox.Bottom+DefaultMinorVerticalTickHeight)
+		r.Stroke()
+	}
+
 	nameStyle := xa.NameStyle.InheritFrom(defaults)
 	if xa.NameStyle.Show && len(xa.Name) > 0 {
 		tb := Draw.MeasureText(r, xa.Name, nameStyle)
@@ -190,7 +237,7 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	}
 
 	if xa.GridMajorStyle.Show || xa.GridMinorStyle.Show {
-		for _, gl := range xa.GetGridLines(ticks) {
+		for _, gl := range xa.getGridLines(ra, ticks) {
 			if (gl.IsMinor && xa.GridMinorStyle.Show) || (!gl.IsMinor && xa.GridMajorStyle.Show) {
 				defaults := xa.GridMajorStyle
 				if gl.IsMinor {
@@ -65,3 +65,26 @@ func TestXAxisMeasure(t *testing.T) {
 	assert.Equal(122, xab.Width())
 	assert.Equal(21, xab.Height())
 }
+
+func TestXAxisMinorTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	xr := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	ticks := []Tick{{Value: 0}, {Value: 5}, {Value: 10}}
+
+	assert.Empty(XAxis{}.GetMinorTicks(xr, ticks))
+
+	xa := XAxis{MinorSubdivisions: 5, GridMajorStyle: StyleShow(), GridMinorStyle: StyleShow()}
+	assert.Equal([]float64{1, 2, 3, 4, 6, 7, 8, 9}, xa.GetMinorTicks(xr, ticks))
+
+	var majors, minors int
+	for _, gl := range xa.getGridLines(xr, ticks) {
+		if gl.IsMinor {
+			minors++
+		} else {
+			majors++
+		}
+	}
+	assert.Equal(1, majors)
+	assert.Equal(8, minors)
+}
@@ -25,6 +25,11 @@ type YAxis struct {
 	TickStyle Style
 	Ticks     []Tick
 
+	// MinorSubdivisions is the number of parts each major tick interval is split into by minor ticks,
+	// i.e. 5 draws four minor ticks between two major ticks. Logarithmic ranges subdivide each decade instead.
+	// Minor ticks and minor grid lines are not drawn if it is less than 2.
+	MinorSubdivisions int
+
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
@@ -67,6 +72,9 @@ func (ya YAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter
 	if len(ya.Ticks) > 0 {
 		return ya.Ticks
 	}
+	if op, isOriented := ra.(OrientedTicksProvider); isOriented {
+		op.SetVertical(true)
+	}
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
 		return tp.GetTicks(r, defaults, vf)
 	}
@@ -82,6 +90,37 @@ func (ya YAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, ya.GridMajorStyle, ya.GridMinorStyle)
 }
 
+// getGridLines returns the gridlines for the axis, preferring gridlines provided by the range
+// over generated gridlines if the user hasn't supplied any.
+func (ya YAxis) getGridLines(ra Range, ticks []Tick) []GridLine {
+	if len(ya.GridLines) > 0 {
+		return ya.GridLines
+	}
+	if glp, isGridLineProvider := ra.(GridLineProvider); isGridLineProvider {
+		gridLines := glp.GetGridLines(ticks, false, ya.GridMajorStyle, ya.GridMinorStyle)
+		if ya.MinorSubdivisions < 2 {
+			// minor grid lines go with minor ticks, so they are left out without minor subdivisions.
+			return majorGridLines(gridLines)
+		}
+		return gridLines
+	}
+	if ya.MinorSubdivisions > 1 {
+		return GenerateGridLinesWithMinorTicks(ra, ticks, ya.GetMinorTicks(ra, ticks), ya.GridMajorStyle, ya.GridMinorStyle)
+	}
+	return ya.GetGridLines(ticks)
+}
+
+// GetMinorTicks returns the minor tick values for the axis, or nothing if it has no minor subdivisions.
+func (ya YAxis) GetMinorTicks(ra Range, ticks []Tick) []float64 {
+	if ya.MinorSubdivisions < 2 {
+		return nil
+	}
+	if mtp, isMinorTicksProvider := ra.(MinorTicksProvider); isMinorTicksProvider {
+		return mtp.GetMinorTicks(ticks, ya.MinorSubdivisions)
+	}
+	return GenerateMinorTicks(ra, ticks, ya.MinorSubdivisions)
+}
+
 // Measure returns the bounds of the axis.
 func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	var tx int
@@ -189,6 +228,18 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		Draw.Text(r, t.Label, finalTextX, finalTextY, tickStyle)
 	}
 
+	tickStyle.GetStrokeOptions().WriteToRenderer(r)
+	for _, v := range ya.GetMinorTicks(ra, ticks) {
+		ly := canvasBox.Bottom - ra.Translate(v)
+		r.MoveTo(lx, ly)
+		if ya.AxisType == YAxisPrimary {
+			r.LineTo(lx+DefaultMinorHorizontalTickWidth, ly)
+		} else if ya.AxisType == YAxisSecondary {
+			r.LineTo(lx-DefaultMinorHorizontalTickWidth, ly)
+		}
+		r.Stroke()
+	}
+
 	nameStyle := ya.NameStyle.InheritFrom(defaults.InheritFrom(Style{TextRotationDegrees: 90}))
 	if ya.NameStyle.Show && len(ya.Name) > 0 {
 		nameStyle.GetTextOptions().WriteToRenderer(r)
@@ -216,7 +267,7 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	}
 
 	if ya.GridMajorStyle.Show || ya.GridMinorStyle.Show {
-		for _, gl := range ya.GetGridLines(ticks) {
+		for _, gl := range ya.getGridLines(ra, ticks) {
 			if (gl.IsMinor && ya.GridMinorStyle.Show) || (!gl.IsMinor && ya.GridMajorStyle.Show) {
 				defaults := ya.GridMajorStyle
 				if gl.IsMinor {